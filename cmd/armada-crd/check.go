@@ -0,0 +1,85 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/keleustes/armada-crd/pkg/integrity"
+	"github.com/keleustes/armada-crd/pkg/legacy"
+)
+
+// runCheck reports the dangling references, the charts shared between
+// groups, the unreachable groups and the dependency cycles of a set of
+// objects read from files or from a cluster.
+func runCheck(args []string) error {
+	fs := flag.NewFlagSet("check", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: armada-crd check [-o text|json] file... (- for stdin)")
+		fmt.Fprintln(fs.Output(), "       armada-crd check [-o text|json] -cluster [-kubeconfig path] [-n namespace]")
+		fs.PrintDefaults()
+	}
+	output := fs.String("o", "text", "output format: text or json")
+	cluster := fs.Bool("cluster", false, "read the objects from the cluster instead of files")
+	kubeconfig := fs.String("kubeconfig", "", "kubeconfig file used with -cluster")
+	namespace := fs.String("n", "", "namespace read with -cluster, all namespaces if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+
+	var bundle *legacy.Bundle
+	var err error
+	if *cluster {
+		if fs.NArg() > 0 {
+			return fmt.Errorf("files can not be combined with -cluster")
+		}
+		bundle, err = readClusterBundle(context.Background(), *kubeconfig, *namespace)
+	} else {
+		paths := fs.Args()
+		if len(paths) == 0 {
+			paths = []string{"-"}
+		}
+		bundle, err = readBundle(paths)
+	}
+	if err != nil {
+		return err
+	}
+
+	report := integrity.Check(bundle.Manifests, bundle.ChartGroups, bundle.Charts)
+
+	if *output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			return err
+		}
+	} else {
+		for _, problem := range report.Problems {
+			fmt.Println(problem)
+		}
+	}
+
+	if !report.OK() {
+		return fmt.Errorf("%d problem(s) found", len(report.Problems))
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+
+	"github.com/keleustes/armada-crd/pkg/client/clientset/versioned"
+	"github.com/keleustes/armada-crd/pkg/legacy"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// restConfig loads a kubeconfig file. The usual loading rules
+// ($KUBECONFIG, ~/.kube/config, in-cluster) apply when kubeconfig is empty.
+func restConfig(kubeconfig string) (*rest.Config, error) {
+	rules := clientcmd.NewDefaultClientConfigLoadingRules()
+	rules.ExplicitPath = kubeconfig
+	return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(rules, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// newClientset builds an armada clientset from a kubeconfig file, see
+// restConfig.
+func newClientset(kubeconfig string) (versioned.Interface, error) {
+	config, err := restConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return versioned.NewForConfig(config)
+}
+
+// readClusterBundle lists the ArmadaChart, ArmadaChartGroup and
+// ArmadaManifest objects of a namespace, or of all the namespaces when
+// namespace is empty.
+func readClusterBundle(ctx context.Context, kubeconfig string, namespace string) (*legacy.Bundle, error) {
+	clientset, err := newClientset(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	client := clientset.ArmadaV1alpha1()
+
+	charts, err := client.ArmadaCharts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	groups, err := client.ArmadaChartGroups(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	manifests, err := client.ArmadaManifests(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &legacy.Bundle{
+		Charts:      charts.Items,
+		ChartGroups: groups.Items,
+		Manifests:   manifests.Items,
+	}, nil
+}
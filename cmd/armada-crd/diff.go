@@ -0,0 +1,264 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/diff"
+	"github.com/keleustes/armada-crd/pkg/revision"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// runDiff prints the changes between the specs of two ArmadaCharts,
+// ArmadaChartGroups or ArmadaManifests.
+func runDiff(args []string) error {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: armada-crd diff [-o text|json] [-show-secrets] [-kubeconfig path] [-n namespace] FROM TO")
+		fmt.Fprintln(fs.Output(), "")
+		fmt.Fprintln(fs.Output(), "FROM and TO are one of:")
+		fmt.Fprintln(fs.Output(), "  file[#name]              an object read from a file (- for stdin), selected by name if there are several")
+		fmt.Fprintln(fs.Output(), "  cluster:kind/name        the live object")
+		fmt.Fprintln(fs.Output(), "  revision:kind/name@N     the spec recorded by revision N of the live object")
+		fmt.Fprintln(fs.Output(), "where kind is armadachart (act), armadachartgroup (acg) or armadamanifest (amf).")
+		fmt.Fprintln(fs.Output(), "")
+		fs.PrintDefaults()
+	}
+	output := fs.String("o", "text", "output format: text or json")
+	showSecrets := fs.Bool("show-secrets", false, "print the values of the secrets instead of redacting them")
+	kubeconfig := fs.String("kubeconfig", "", "kubeconfig file used for the live objects and revisions")
+	namespace := fs.String("n", "default", "namespace of the live objects and revisions")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "text" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return fmt.Errorf("expected 2 arguments, got %d", fs.NArg())
+	}
+
+	ctx := context.Background()
+	from, err := loadDiffSource(ctx, fs.Arg(0), *kubeconfig, *namespace)
+	if err != nil {
+		return fmt.Errorf("%s: %v", fs.Arg(0), err)
+	}
+	to, err := loadDiffSource(ctx, fs.Arg(1), *kubeconfig, *namespace)
+	if err != nil {
+		return fmt.Errorf("%s: %v", fs.Arg(1), err)
+	}
+	if from.kind != to.kind {
+		return fmt.Errorf("can not compare the %s %s with the %s %s", from.kind, from.name, to.kind, to.name)
+	}
+
+	changes, err := diff.Compare(from.spec, to.spec, diff.Options{Prefix: "spec", ShowSecrets: *showSecrets})
+	if err != nil {
+		return err
+	}
+	if *output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.SetEscapeHTML(false)
+		return encoder.Encode(changes)
+	}
+	return diff.Write(os.Stdout, changes)
+}
+
+// diffSource is the spec of one side of a diff
+type diffSource struct {
+	kind string
+	name string
+	spec interface{}
+}
+
+func loadDiffSource(ctx context.Context, source string, kubeconfig string, namespace string) (*diffSource, error) {
+	switch {
+	case strings.HasPrefix(source, "cluster:"):
+		kind, name, err := parseObjectRef(strings.TrimPrefix(source, "cluster:"))
+		if err != nil {
+			return nil, err
+		}
+		obj, err := getClusterObject(ctx, kubeconfig, namespace, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		return sourceOf(obj), nil
+
+	case strings.HasPrefix(source, "revision:"):
+		ref := strings.TrimPrefix(source, "revision:")
+		at := strings.LastIndex(ref, "@")
+		if at < 0 {
+			return nil, fmt.Errorf("missing @revision")
+		}
+		number, err := strconv.ParseInt(ref[at+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid revision %q", ref[at+1:])
+		}
+		kind, name, err := parseObjectRef(ref[:at])
+		if err != nil {
+			return nil, err
+		}
+		obj, err := getClusterObject(ctx, kubeconfig, namespace, kind, name)
+		if err != nil {
+			return nil, err
+		}
+		rev, err := getRevision(ctx, kubeconfig, obj, number)
+		if err != nil {
+			return nil, err
+		}
+		res := sourceOf(obj)
+		res.spec = newSpec(kind)
+		if err := revision.Spec(rev, res.spec); err != nil {
+			return nil, err
+		}
+		return res, nil
+
+	default:
+		path, name := source, ""
+		if hash := strings.LastIndex(source, "#"); hash >= 0 {
+			path, name = source[:hash], source[hash+1:]
+		}
+		return readFileSource(path, name)
+	}
+}
+
+// parseObjectRef parses kind/name, the kind being the name of the
+// resource, singular or plural, or its short name.
+func parseObjectRef(ref string) (string, string, error) {
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return "", "", fmt.Errorf("expected kind/name, got %q", ref)
+	}
+	switch strings.ToLower(parts[0]) {
+	case "armadachart", "armadacharts", "act":
+		return "ArmadaChart", parts[1], nil
+	case "armadachartgroup", "armadachartgroups", "acg":
+		return "ArmadaChartGroup", parts[1], nil
+	case "armadamanifest", "armadamanifests", "amf":
+		return "ArmadaManifest", parts[1], nil
+	}
+	return "", "", fmt.Errorf("unknown kind %q", parts[0])
+}
+
+func getClusterObject(ctx context.Context, kubeconfig string, namespace string, kind string, name string) (metav1.Object, error) {
+	clientset, err := newClientset(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	client := clientset.ArmadaV1alpha1()
+	switch kind {
+	case "ArmadaChart":
+		return client.ArmadaCharts(namespace).Get(ctx, name, metav1.GetOptions{})
+	case "ArmadaChartGroup":
+		return client.ArmadaChartGroups(namespace).Get(ctx, name, metav1.GetOptions{})
+	default:
+		return client.ArmadaManifests(namespace).Get(ctx, name, metav1.GetOptions{})
+	}
+}
+
+// getRevision returns the given revision of the history of owner
+func getRevision(ctx context.Context, kubeconfig string, owner metav1.Object, number int64) (*appsv1.ControllerRevision, error) {
+	config, err := restConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	list, err := clientset.AppsV1().ControllerRevisions(owner.GetNamespace()).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*appsv1.ControllerRevision, 0)
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], owner) {
+			revisions = append(revisions, &list.Items[i])
+		}
+	}
+	return revision.Find(revisions, number)
+}
+
+// readFileSource returns the object of a file, the one named name if
+// the file holds several.
+func readFileSource(path string, name string) (*diffSource, error) {
+	bundle, err := readBundle([]string{path})
+	if err != nil {
+		return nil, err
+	}
+	candidates := make([]*diffSource, 0)
+	for i := range bundle.Charts {
+		candidates = append(candidates, sourceOf(&bundle.Charts[i]))
+	}
+	for i := range bundle.ChartGroups {
+		candidates = append(candidates, sourceOf(&bundle.ChartGroups[i]))
+	}
+	for i := range bundle.Manifests {
+		candidates = append(candidates, sourceOf(&bundle.Manifests[i]))
+	}
+
+	if name != "" {
+		selected := make([]*diffSource, 0)
+		for _, candidate := range candidates {
+			if candidate.name == name {
+				selected = append(selected, candidate)
+			}
+		}
+		candidates = selected
+	}
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("no armada object found")
+	case 1:
+		return candidates[0], nil
+	default:
+		return nil, fmt.Errorf("%d armada objects found, select one with #name", len(candidates))
+	}
+}
+
+func sourceOf(obj metav1.Object) *diffSource {
+	switch o := obj.(type) {
+	case *av1.ArmadaChart:
+		return &diffSource{kind: "ArmadaChart", name: o.Name, spec: &o.Spec}
+	case *av1.ArmadaChartGroup:
+		return &diffSource{kind: "ArmadaChartGroup", name: o.Name, spec: &o.Spec}
+	case *av1.ArmadaManifest:
+		return &diffSource{kind: "ArmadaManifest", name: o.Name, spec: &o.Spec}
+	}
+	return &diffSource{name: obj.GetName()}
+}
+
+// newSpec returns an empty spec of the kind, to decode a revision into
+func newSpec(kind string) interface{} {
+	switch kind {
+	case "ArmadaChart":
+		return &av1.ArmadaChartSpec{}
+	case "ArmadaChartGroup":
+		return &av1.ArmadaChartGroupSpec{}
+	default:
+		return &av1.ArmadaManifestSpec{}
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/keleustes/armada-crd/pkg/legacy"
+)
+
+// runExport converts an ArmadaManifest, with the chart groups and charts it
+// references, back into a legacy Armada bundle written to stdout.
+func runExport(args []string) error {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: armada-crd export [-manifest name] file... (- for stdin)")
+		fs.PrintDefaults()
+	}
+	name := fs.String("manifest", "", "name of the ArmadaManifest to export, optional if the input holds only one")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	paths := fs.Args()
+	if len(paths) == 0 {
+		paths = []string{"-"}
+	}
+
+	bundle, err := readBundle(paths)
+	if err != nil {
+		return err
+	}
+
+	if *name == "" {
+		if len(bundle.Manifests) != 1 {
+			return fmt.Errorf("found %d ArmadaManifest(s), use -manifest to select one", len(bundle.Manifests))
+		}
+		*name = bundle.Manifests[0].Name
+	}
+
+	docs, err := bundle.Export(*name)
+	if err != nil {
+		return err
+	}
+	return legacy.WriteDocuments(os.Stdout, docs)
+}
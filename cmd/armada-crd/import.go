@@ -0,0 +1,60 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/legacy"
+)
+
+// runImport converts a legacy Armada bundle into armada custom resources
+// and writes them to stdout. Unsupported fields are reported on stderr.
+func runImport(args []string) error {
+	fs := flag.NewFlagSet("import", flag.ExitOnError)
+	file := fs.String("f", "-", "legacy Armada bundle to convert, - for stdin")
+	namespace := fs.String("n", "", "namespace of the generated objects")
+	targetState := fs.String("target-state", av1.StateDeployed.String(), "target_state of the generated objects")
+	strict := fs.Bool("strict", false, "fail if a field of the bundle can not be represented")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	in, err := openInput(*file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	bundle, issues, err := legacy.Import(in, legacy.ImportOptions{
+		Namespace:   *namespace,
+		TargetState: av1.HelmResourceState(*targetState),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range issues {
+		fmt.Fprintf(os.Stderr, "warning: %s\n", issue)
+	}
+	if *strict && len(issues) > 0 {
+		return fmt.Errorf("%d issue(s) found in %s", len(issues), *file)
+	}
+
+	return writeObjects(os.Stdout, bundle.Objects())
+}
@@ -0,0 +1,113 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/legacy"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// readBundle reads the ArmadaChart, ArmadaChartGroup and ArmadaManifest
+// objects from YAML files, as produced by "kubectl get -o yaml" or by the
+// import command. List kinds are expanded and other kinds are ignored.
+func readBundle(paths []string) (*legacy.Bundle, error) {
+	bundle := &legacy.Bundle{
+		Charts:      make([]av1.ArmadaChart, 0),
+		ChartGroups: make([]av1.ArmadaChartGroup, 0),
+		Manifests:   make([]av1.ArmadaManifest, 0),
+	}
+
+	for _, path := range paths {
+		in, err := openInput(path)
+		if err != nil {
+			return nil, err
+		}
+		err = readObjects(in, bundle)
+		in.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+	return bundle, nil
+}
+
+func readObjects(r io.Reader, bundle *legacy.Bundle) error {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	for {
+		raw, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(string(raw)) == "" {
+			continue
+		}
+
+		u := &unstructured.Unstructured{}
+		if err := utilyaml.Unmarshal(raw, &u.Object); err != nil {
+			return err
+		}
+		if u.Object == nil {
+			continue
+		}
+		if err := addObject(u, bundle); err != nil {
+			return err
+		}
+	}
+}
+
+func addObject(u *unstructured.Unstructured, bundle *legacy.Bundle) error {
+	if u.IsList() {
+		return u.EachListItem(func(item runtime.Object) error {
+			return addObject(item.(*unstructured.Unstructured), bundle)
+		})
+	}
+
+	if u.GroupVersionKind().GroupVersion() != av1.SchemeGroupVersion {
+		return nil
+	}
+
+	switch u.GetKind() {
+	case "ArmadaChart":
+		var obj av1.ArmadaChart
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+			return fmt.Errorf("ArmadaChart %s: %v", u.GetName(), err)
+		}
+		bundle.Charts = append(bundle.Charts, obj)
+	case "ArmadaChartGroup":
+		var obj av1.ArmadaChartGroup
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+			return fmt.Errorf("ArmadaChartGroup %s: %v", u.GetName(), err)
+		}
+		bundle.ChartGroups = append(bundle.ChartGroups, obj)
+	case "ArmadaManifest":
+		var obj av1.ArmadaManifest
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+			return fmt.Errorf("ArmadaManifest %s: %v", u.GetName(), err)
+		}
+		bundle.Manifests = append(bundle.Manifests, obj)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// armada-crd is the command line companion of the armada custom resources.
+// It converts, checks and renders ArmadaChart, ArmadaChartGroup and
+// ArmadaManifest objects without the need of a running operator, and dry
+// runs the restore of a backup.
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+)
+
+// command is a subcommand of armada-crd. The run function receives the
+// arguments following the name of the subcommand.
+type command struct {
+	summary string
+	run     func(args []string) error
+}
+
+var commands = map[string]command{
+	"check":        {summary: "report dangling references, shared charts, unreachable groups and dependency cycles", run: runCheck},
+	"diff":         {summary: "print the field changes between two specs read from files, revisions or the cluster", run: runDiff},
+	"export":       {summary: "convert an ArmadaManifest and its objects into a legacy Armada YAML bundle", run: runExport},
+	"import":       {summary: "convert a legacy Armada YAML bundle into armada custom resources", run: runImport},
+	"plan":         {summary: "print the ordered releases, waves and wait/test settings of an ArmadaManifest", run: runPlan},
+	"restore-plan": {summary: "verify the backup of an ArmadaRestore and print what restoring it would change", run: runRestorePlan},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	name := os.Args[1]
+	if name == "help" || name == "-h" || name == "--help" {
+		usage()
+		return
+	}
+
+	cmd, ok := commands[name]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "armada-crd: unknown command %q\n\n", name)
+		usage()
+		os.Exit(2)
+	}
+
+	if err := cmd.run(os.Args[2:]); err != nil {
+		fmt.Fprintf(os.Stderr, "armada-crd %s: %v\n", name, err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: armada-crd <command> [flags]")
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Commands:")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		fmt.Fprintf(os.Stderr, "  %-13s %s\n", name, commands[name].summary)
+	}
+	fmt.Fprintln(os.Stderr, "")
+	fmt.Fprintln(os.Stderr, "Run 'armada-crd <command> -h' for the flags of a command.")
+}
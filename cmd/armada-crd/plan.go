@@ -0,0 +1,110 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/legacy"
+	"github.com/keleustes/armada-crd/pkg/plan"
+	yaml "sigs.k8s.io/yaml"
+)
+
+// runPlan prints the ordered list of the releases deployed by a manifest
+// read from files or from a cluster.
+func runPlan(args []string) error {
+	fs := flag.NewFlagSet("plan", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: armada-crd plan [-o yaml|json] [-m manifest] file... (- for stdin)")
+		fmt.Fprintln(fs.Output(), "       armada-crd plan [-o yaml|json] [-m manifest] -cluster [-kubeconfig path] [-n namespace]")
+		fs.PrintDefaults()
+	}
+	output := fs.String("o", "yaml", "output format: yaml or json")
+	manifestName := fs.String("m", "", "name of the ArmadaManifest, required if there are several")
+	cluster := fs.Bool("cluster", false, "read the objects from the cluster instead of files")
+	kubeconfig := fs.String("kubeconfig", "", "kubeconfig file used with -cluster")
+	namespace := fs.String("n", "", "namespace read with -cluster, all namespaces if empty")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "yaml" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+
+	var bundle *legacy.Bundle
+	var err error
+	if *cluster {
+		if fs.NArg() > 0 {
+			return fmt.Errorf("files can not be combined with -cluster")
+		}
+		bundle, err = readClusterBundle(context.Background(), *kubeconfig, *namespace)
+	} else {
+		paths := fs.Args()
+		if len(paths) == 0 {
+			paths = []string{"-"}
+		}
+		bundle, err = readBundle(paths)
+	}
+	if err != nil {
+		return err
+	}
+
+	manifest, err := selectManifest(bundle.Manifests, *manifestName)
+	if err != nil {
+		return err
+	}
+	p, err := plan.New(manifest, bundle.ChartGroups, bundle.Charts)
+	if err != nil {
+		return fmt.Errorf("ArmadaManifest %s: %v", manifest.Name, err)
+	}
+
+	if *output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(p)
+	}
+	blob, err := yaml.Marshal(p)
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(blob)
+	return err
+}
+
+// selectManifest returns the manifest with the given name, or the only
+// manifest if name is empty.
+func selectManifest(manifests []av1.ArmadaManifest, name string) (*av1.ArmadaManifest, error) {
+	if name == "" {
+		switch len(manifests) {
+		case 0:
+			return nil, fmt.Errorf("no ArmadaManifest found")
+		case 1:
+			return &manifests[0], nil
+		default:
+			return nil, fmt.Errorf("%d ArmadaManifests found, select one with -m", len(manifests))
+		}
+	}
+	for i := range manifests {
+		if manifests[i].Name == name {
+			return &manifests[i], nil
+		}
+	}
+	return nil, fmt.Errorf("ArmadaManifest %q not found", name)
+}
@@ -0,0 +1,220 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/archive"
+	"github.com/keleustes/armada-crd/pkg/backup/restore"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"github.com/keleustes/armada-crd/pkg/client/clientset/versioned"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	yaml "sigs.k8s.io/yaml"
+)
+
+// runRestorePlan dry runs an ArmadaRestore of the cluster: the backup is
+// opened and verified, then compared with the charts and releases of the
+// cluster, and the plan is printed.
+func runRestorePlan(args []string) error {
+	fs := flag.NewFlagSet("restore-plan", flag.ExitOnError)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: armada-crd restore-plan [-o text|yaml|json] [-kubeconfig path] [-n namespace] [-archive file] [-update-status] RESTORE")
+		fmt.Fprintln(fs.Output(), "")
+		fmt.Fprintln(fs.Output(), "RESTORE is the name of the ArmadaRestore. Its backup is read from the RestoreSource,")
+		fmt.Fprintln(fs.Output(), "with the credentials of its secret, or from the -archive file (- for stdin).")
+		fmt.Fprintln(fs.Output(), "")
+		fs.PrintDefaults()
+	}
+	output := fs.String("o", "text", "output format: text, yaml or json")
+	kubeconfig := fs.String("kubeconfig", "", "kubeconfig file")
+	namespace := fs.String("n", "default", "namespace of the ArmadaRestore")
+	archivePath := fs.String("archive", "", "file holding the backup, read instead of the RestoreSource")
+	updateStatus := fs.Bool("update-status", false, "record the plan in the status of the ArmadaRestore")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *output != "text" && *output != "yaml" && *output != "json" {
+		return fmt.Errorf("unknown output format %q", *output)
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return fmt.Errorf("expected 1 argument, got %d", fs.NArg())
+	}
+
+	ctx := context.Background()
+	config, err := restConfig(*kubeconfig)
+	if err != nil {
+		return err
+	}
+	clientset, err := versioned.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	kubeClientset, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return err
+	}
+	obj, err := clientset.ArmadaV1alpha1().ArmadaRestores(*namespace).Get(ctx, fs.Arg(0), metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	var r io.ReadCloser
+	if *archivePath != "" {
+		r, err = openInput(*archivePath)
+	} else {
+		r, err = openRestoreSource(ctx, kubeClientset, obj)
+	}
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	var plan *av1.RestorePlan
+	index, states, err := archive.Read(r)
+	if err != nil {
+		plan = &av1.RestorePlan{Errors: []string{err.Error()}}
+	} else {
+		live, err := readLive(ctx, clientset, kubeClientset, obj.Namespace, states)
+		if err != nil {
+			return err
+		}
+		plan = restore.Plan(obj, index, states, live)
+	}
+
+	if *updateStatus {
+		obj.Status.Plan = plan
+		if _, err := clientset.ArmadaV1alpha1().ArmadaRestores(obj.Namespace).UpdateStatus(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	switch *output {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(plan)
+	case "yaml":
+		var blob []byte
+		if blob, err = yaml.Marshal(plan); err == nil {
+			_, err = os.Stdout.Write(blob)
+		}
+	default:
+		err = writeRestorePlan(os.Stdout, plan)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(plan.Errors) > 0 {
+		return fmt.Errorf("%d problem(s) found in the backup", len(plan.Errors))
+	}
+	return nil
+}
+
+// openRestoreSource opens the backup of the RestoreSource, with the
+// credentials of the secret it names
+func openRestoreSource(ctx context.Context, clientset kubernetes.Interface, obj *av1.ArmadaRestore) (io.ReadCloser, error) {
+	spec := &obj.Spec
+	secretName := ""
+	switch {
+	case spec.BackupStorageType == av1.BackupStorageTypeOffsite && spec.Offsite != nil:
+		secretName = spec.Offsite.OffsiteSecret
+	case spec.BackupStorageType == av1.BackupStorageTypeCeph && spec.Ceph != nil:
+		secretName = spec.Ceph.CephSecret
+	case spec.BackupStorageType == av1.BackupStorageTypeLocal && spec.Local != nil && spec.Local.PersistentVolumeClaim != "":
+		return nil, fmt.Errorf("the backup is on the PersistentVolumeClaim %s, copy it and use -archive", spec.Local.PersistentVolumeClaim)
+	}
+
+	creds := storage.Credentials{}
+	if secretName != "" {
+		secret, err := clientset.CoreV1().Secrets(obj.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if spec.BackupStorageType == av1.BackupStorageTypeOffsite {
+			creds, err = storage.OffsiteCredentials(secret.Data)
+		} else {
+			creds, err = storage.CephCredentials(secret.Data)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Secret %s: %v", secretName, err)
+		}
+	}
+	return restore.Open(ctx, spec, creds)
+}
+
+// readLive lists the ArmadaCharts of the namespace of the restore and the
+// helm release Secrets of the namespaces of the backed up releases
+func readLive(ctx context.Context, clientset versioned.Interface, kubeClientset kubernetes.Interface, namespace string, states []archive.ChartState) (restore.Live, error) {
+	live := restore.Live{}
+	charts, err := clientset.ArmadaV1alpha1().ArmadaCharts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return live, err
+	}
+	live.Charts = charts.Items
+
+	namespaces := sets.New[string]()
+	for _, state := range states {
+		for _, secret := range state.Releases {
+			namespaces.Insert(secret.Namespace)
+		}
+	}
+	for _, ns := range sets.List(namespaces) {
+		secrets, err := kubeClientset.CoreV1().Secrets(ns).List(ctx, metav1.ListOptions{LabelSelector: restore.HelmOwnerSelector})
+		if err != nil {
+			return live, err
+		}
+		live.Releases = append(live.Releases, secrets.Items...)
+	}
+	return live, nil
+}
+
+// writeRestorePlan prints a plan as aligned columns
+func writeRestorePlan(w io.Writer, plan *av1.RestorePlan) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	if plan.Created != nil {
+		fmt.Fprintf(tw, "Backup %s, written %s, format version %d\n", plan.Backup, plan.Created.UTC().Format(time.RFC3339), plan.FormatVersion)
+	}
+	section := func(title string, items []av1.RestorePlanItem) {
+		if len(items) == 0 {
+			return
+		}
+		fmt.Fprintf(tw, "\n%s:\n", title)
+		for _, item := range items {
+			fmt.Fprintf(tw, "  %s\t%s/%s\t%s\n", item.Action, item.Namespace, item.Name, item.Reason)
+		}
+	}
+	section("ArmadaCharts", plan.Charts)
+	section("Releases", plan.Releases)
+	if len(plan.Errors) > 0 {
+		fmt.Fprintf(tw, "\nErrors:\n")
+		for _, msg := range plan.Errors {
+			fmt.Fprintf(tw, "  %s\n", msg)
+		}
+	}
+	return tw.Flush()
+}
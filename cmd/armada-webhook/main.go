@@ -0,0 +1,94 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// armada-webhook serves the defaulting and validating admission webhooks of
+// the armada custom resources, and the /convert endpoint of the conversion
+// between their v1alpha1 and v1beta1 versions. The webhook configurations
+// are generated in kubectl/webhook by `make generate-manifests`.
+package main
+
+import (
+	"flag"
+	"os"
+
+	"github.com/keleustes/armada-crd/pkg/apis"
+	armadawebhook "github.com/keleustes/armada-crd/pkg/webhook"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+func main() {
+	var port int
+	var certDir string
+	var metricsAddr string
+	var probeAddr string
+	flag.IntVar(&port, "port", 9443, "port the webhook server listens on")
+	flag.StringVar(&certDir, "cert-dir", "", "directory containing tls.crt and tls.key (defaults to the controller-runtime location)")
+	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "address the metrics endpoint binds to, 0 disables it")
+	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "address the health probe endpoint binds to")
+	opts := zap.Options{}
+	opts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	log := ctrl.Log.WithName("armada-webhook")
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		log.Error(err, "unable to register the kubernetes types")
+		os.Exit(1)
+	}
+	if err := apis.AddToScheme(scheme); err != nil {
+		log.Error(err, "unable to register the armada types")
+		os.Exit(1)
+	}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+		Scheme:                 scheme,
+		Metrics:                metricsserver.Options{BindAddress: metricsAddr},
+		HealthProbeBindAddress: probeAddr,
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Port:    port,
+			CertDir: certDir,
+		}),
+	})
+	if err != nil {
+		log.Error(err, "unable to create the manager")
+		os.Exit(1)
+	}
+
+	if err := armadawebhook.SetupWithManager(mgr); err != nil {
+		log.Error(err, "unable to register the webhooks")
+		os.Exit(1)
+	}
+	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
+		log.Error(err, "unable to set up the health check")
+		os.Exit(1)
+	}
+	if err := mgr.AddReadyzCheck("readyz", mgr.GetWebhookServer().StartedChecker()); err != nil {
+		log.Error(err, "unable to set up the ready check")
+		os.Exit(1)
+	}
+
+	log.Info("starting the webhook server", "port", port)
+	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
+		log.Error(err, "webhook server stopped")
+		os.Exit(1)
+	}
+}
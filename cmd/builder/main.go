@@ -101,6 +101,12 @@ func createOpenAPIBuilderConfig() *common.Config {
 		},
 		GetDefinitionName: func(name string) (string, spec.Extensions) {
 			gvk := name[strings.LastIndex(name, "/")+1:]
+			if !strings.Contains(name, "/") {
+				// Types implementing OpenAPIModelName are already keyed by
+				// their dotted canonical name, e.g: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+				parts := strings.Split(name, ".")
+				gvk = strings.Join(parts[len(parts)-2:], ".")
+			}
 			kind := gvk[strings.LastIndex(gvk, ".")+1:]
 			version := gvk[:strings.LastIndex(gvk, ".")]
 			log.Printf("kind %s version %s", kind, version)
@@ -112,7 +118,7 @@ func createOpenAPIBuilderConfig() *common.Config {
 			friendlyName := prefix + gvk
 			var extensions spec.Extensions
 			switch kind {
-			case "ArmadaChart", "ArmadaChartGroup", "ArmadaManifest":
+			case "ArmadaChart", "ArmadaChartGroup", "ArmadaManifest", "ArmadaBackupSchedule", "ArmadaRestore":
 				extensions = spec.Extensions{"x-kubernetes-group-version-kind": map[string]interface{}{
 					"group":   "armada.airshipit.org",
 					"kind":    kind,
@@ -133,6 +139,8 @@ func createWebServices() []*restful.WebService {
 	w.Route(buildRouteForType(w, "v1alpha1", "ArmadaChart"))
 	w.Route(buildRouteForType(w, "v1alpha1", "ArmadaChartGroup"))
 	w.Route(buildRouteForType(w, "v1alpha1", "ArmadaManifest"))
+	w.Route(buildRouteForType(w, "v1alpha1", "ArmadaBackupSchedule"))
+	w.Route(buildRouteForType(w, "v1alpha1", "ArmadaRestore"))
 	return []*restful.WebService{w}
 }
 
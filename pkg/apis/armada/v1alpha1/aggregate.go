@@ -0,0 +1,151 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"github.com/keleustes/armada-crd/pkg/lifecycle"
+)
+
+// ChildrenStatus summarizes the states of the charts of an ArmadaChartGroup
+// or of the chart groups of an ArmadaManifest. Library charts are not
+// deployed and are not counted.
+type ChildrenStatus struct {
+	// Total number of children
+	Total int32 `json:"total"`
+	// Ready children meet their target state, deployed or uninstalled
+	Ready int32 `json:"ready"`
+	// Pending children are enabled but neither ready nor failed yet. A
+	// child which does not exist is pending too.
+	Pending int32 `json:"pending"`
+	// Failed children are in the failed or error state
+	Failed int32 `json:"failed"`
+	// Disabled children have not been enabled yet
+	Disabled int32 `json:"disabled"`
+	// Failing lists the names of the failed children
+	Failing []string `json:"failing,omitempty"`
+	// Progress is the percentage of ready children
+	Progress int32 `json:"progress"`
+	// Blocking is the first child, in the order listed by the spec, which
+	// is not ready.
+	Blocking string `json:"blocking,omitempty"`
+}
+
+// childState is what the aggregation needs to know about a child
+type childState struct {
+	found    bool
+	library  bool
+	ready    bool
+	failed   bool
+	disabled bool
+}
+
+// aggregate counts the children in the order given by names
+func aggregate(names []string, states map[string]childState) *ChildrenStatus {
+	res := &ChildrenStatus{}
+	for _, name := range names {
+		state := states[name]
+		if state.library {
+			continue
+		}
+		res.Total++
+		switch {
+		case !state.found:
+			res.Pending++
+		case state.ready:
+			res.Ready++
+			continue
+		case state.failed:
+			res.Failed++
+			res.Failing = append(res.Failing, name)
+		case state.disabled:
+			res.Disabled++
+		default:
+			res.Pending++
+		}
+		if res.Blocking == "" {
+			res.Blocking = name
+		}
+	}
+	res.Progress = 100
+	if res.Total > 0 {
+		res.Progress = res.Ready * 100 / res.Total
+	}
+	return res
+}
+
+// deriveFrom sets the actual state of a chart group or manifest from the
+// summary of its children: failed as soon as one child failed, the target
+// state of the parent once every child meets its own, uninitialized while
+// no child is enabled and running in between.
+func (s *ArmadaStatus) deriveFrom(children *ChildrenStatus, target HelmResourceState) {
+	s.Reason = ""
+	switch {
+	case children.Failed > 0:
+		s.ActualState = StateFailed
+		s.Reason = "failed: " + strings.Join(children.Failing, ", ")
+	case children.Ready == children.Total:
+		s.ActualState = target
+	case children.Disabled == children.Total:
+		s.ActualState = StateUninitialized
+	default:
+		s.ActualState = StateRunning
+	}
+	s.Satisfied = lifecycle.Satisfied(lifecycle.State(s.ActualState), lifecycle.State(target))
+}
+
+// Aggregate summarizes the states of the charts listed by names
+func (obj *ArmadaCharts) Aggregate(names []string) *ChildrenStatus {
+	states := make(map[string]childState)
+	for _, act := range obj.List.Items {
+		states[act.Name] = childState{
+			found:    true,
+			library:  act.IsLibrary(),
+			ready:    act.IsSatisfied() && !act.IsTargetStateUninitialized(),
+			failed:   act.IsFailedOrError(),
+			disabled: act.IsTargetStateUninitialized(),
+		}
+	}
+	return aggregate(names, states)
+}
+
+// Aggregate summarizes the states of the chart groups listed by names
+func (obj *ArmadaChartGroups) Aggregate(names []string) *ChildrenStatus {
+	states := make(map[string]childState)
+	for _, act := range obj.List.Items {
+		states[act.Name] = childState{
+			found:    true,
+			ready:    act.IsSatisfied() && !act.IsTargetStateUninitialized(),
+			failed:   act.IsFailedOrError(),
+			disabled: act.IsTargetStateUninitialized(),
+		}
+	}
+	return aggregate(names, states)
+}
+
+// AggregateStatus updates the summary of the charts of the group, and
+// derives the actual state of the group from it
+func (obj *ArmadaChartGroup) AggregateStatus(charts *ArmadaCharts) {
+	obj.Status.Children = charts.Aggregate(obj.Spec.Charts)
+	obj.Status.deriveFrom(obj.Status.Children, obj.Spec.TargetState)
+}
+
+// AggregateStatus updates the summary of the chart groups of the manifest,
+// and derives the actual state of the manifest from it
+func (obj *ArmadaManifest) AggregateStatus(groups *ArmadaChartGroups) {
+	obj.Status.Children = groups.Aggregate(obj.Spec.ChartGroups)
+	obj.Status.deriveFrom(obj.Status.Children, obj.Spec.TargetState)
+}
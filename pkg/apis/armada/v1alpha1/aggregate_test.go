@@ -0,0 +1,105 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestAggregateStatus(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	chart := func(name string, target HelmResourceState, actual HelmResourceState) ArmadaChart {
+		act := newGraphChart(name)
+		act.Spec.TargetState = target
+		act.Status.ActualState = actual
+		return act
+	}
+	htk := chart("helm-toolkit", StateUninitialized, StateUninitialized)
+	htk.Spec.Library = true
+	charts := &ArmadaCharts{List: &ArmadaChartList{Items: []ArmadaChart{
+		htk,
+		chart("mariadb", StateDeployed, StateDeployed),
+		chart("memcached", StateDeployed, StateDeployed),
+		chart("keystone", StateDeployed, StateFailed),
+		chart("glance", StateDeployed, StateRunning),
+		chart("nova", StateUninitialized, StateUninitialized),
+		chart("barbican", StateUninstalled, StateUninstalled),
+	}}}
+
+	group := &ArmadaChartGroup{Spec: ArmadaChartGroupSpec{
+		TargetState: StateDeployed,
+		Charts:      []string{"helm-toolkit", "mariadb", "memcached", "barbican", "glance", "keystone", "nova", "heat"},
+	}}
+	group.AggregateStatus(charts)
+	g.Expect(group.Status.Children).To(gomega.Equal(&ChildrenStatus{
+		Total:    7,
+		Ready:    3,
+		Pending:  2,
+		Failed:   1,
+		Disabled: 1,
+		Failing:  []string{"keystone"},
+		Progress: 42,
+		Blocking: "glance",
+	}))
+	g.Expect(group.Status.ActualState).To(gomega.Equal(StateFailed))
+	g.Expect(group.Status.Reason).To(gomega.Equal("failed: keystone"))
+	g.Expect(group.Status.Satisfied).To(gomega.BeFalse())
+
+	// A chart which is uninstalled as targeted counts as ready
+	group.Spec.Charts = []string{"helm-toolkit", "mariadb", "barbican"}
+	group.AggregateStatus(charts)
+	g.Expect(group.Status.Children.Progress).To(gomega.Equal(int32(100)))
+	g.Expect(group.Status.ActualState).To(gomega.Equal(StateDeployed))
+	g.Expect(group.Status.Reason).To(gomega.BeEmpty())
+	g.Expect(group.Status.Satisfied).To(gomega.BeTrue())
+
+	group.Spec.Charts = []string{"mariadb", "glance"}
+	group.AggregateStatus(charts)
+	g.Expect(group.Status.ActualState).To(gomega.Equal(StateRunning))
+	g.Expect(group.Status.Satisfied).To(gomega.BeFalse())
+
+	// Nothing is enabled in a group disabled by its manifest
+	group.Spec.TargetState = StateUninitialized
+	group.Spec.Charts = []string{"nova"}
+	group.AggregateStatus(charts)
+	g.Expect(group.Status.ActualState).To(gomega.Equal(StateUninitialized))
+	g.Expect(group.Status.Satisfied).To(gomega.BeTrue())
+
+	groups := &ArmadaChartGroups{List: &ArmadaChartGroupList{Items: []ArmadaChartGroup{
+		{ObjectMeta: metav1.ObjectMeta{Name: "infra"}, Spec: ArmadaChartGroupSpec{TargetState: StateDeployed}, Status: ArmadaChartGroupStatus{ArmadaStatus: ArmadaStatus{ActualState: StateDeployed}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "openstack"}, Spec: ArmadaChartGroupSpec{TargetState: StateUninitialized}},
+	}}}
+	manifest := &ArmadaManifest{Spec: ArmadaManifestSpec{TargetState: StateDeployed, ChartGroups: []string{"infra", "openstack"}}}
+	manifest.AggregateStatus(groups)
+	g.Expect(manifest.Status.Children).To(gomega.Equal(&ChildrenStatus{
+		Total:    2,
+		Ready:    1,
+		Disabled: 1,
+		Progress: 50,
+		Blocking: "openstack",
+	}))
+	g.Expect(manifest.Status.ActualState).To(gomega.Equal(StateRunning))
+	g.Expect(manifest.Status.Satisfied).To(gomega.BeFalse())
+
+	manifest.Spec.ChartGroups = nil
+	manifest.AggregateStatus(groups)
+	g.Expect(manifest.Status.Children.Progress).To(gomega.Equal(int32(100)))
+	g.Expect(manifest.Status.ActualState).To(gomega.Equal(StateDeployed))
+	g.Expect(manifest.Status.Satisfied).To(gomega.BeTrue())
+}
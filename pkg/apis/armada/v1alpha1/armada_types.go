@@ -20,7 +20,9 @@
 
 package v1alpha1
 
-import ()
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
 
 // Native
 type ArmadaWaitNative struct {
@@ -34,7 +36,7 @@ type ArmadaWaitResourcesItems struct {
 	Labels *map[string]string `json:"labels,omitempty"`
 	// Only for controller ``type``s. Amount of pods in a controller which must be ready.
 	// Can be integer or percent string e.g. ``80%``. Default ``100%``.
-	MinReady int `json:"min_ready,omitempty"`
+	MinReady *intstr.IntOrString `json:"min_ready,omitempty"`
 	// k8s resource type, supports: controllers ('deployment', 'daemonset', 'statefulset', 'pod', 'job')
 	Type string `json:"type"`
 }
@@ -10,6 +10,7 @@ import (
 const (
 	BackupStorageTypeOffsite BackupStorageType = "Offsite"
 	BackupStorageTypeCeph    BackupStorageType = "Ceph"
+	BackupStorageTypeLocal   BackupStorageType = "Local"
 )
 
 // Secret keys and file names for the backup storage backends. These are plain
@@ -24,6 +25,9 @@ const (
 	// Ceph related consts
 	CephAccessToken     = "access-token"
 	CephCredentialsJson = "credentials.json"
+
+	// Local related consts
+	LocalVolumeMountPath = "/var/lib/armada/backups"
 )
 
 type BackupStorageType string
@@ -63,6 +67,8 @@ type BackupSource struct {
 	Offsite *OffsiteBackupSource `json:"offsite,omitempty"`
 	// Ceph defines the Ceph backup source spec.
 	Ceph *CephBackupSource `json:"ceph,omitempty"`
+	// Local defines the Local backup source spec.
+	Local *LocalBackupSource `json:"local,omitempty"`
 }
 
 // OffsiteBackupSource provides the spec how to store backups on Offsite.
@@ -97,13 +103,25 @@ type CephBackupSource struct {
 	// e.g: "mycephbucket/armada.backup"
 	Path string `json:"path"`
 
-	// The name of the secret object that stores the Google storage credential
-	// containing at most ONE of the following:
-	// An access token with file name of 'access-token'.
-	// JSON credentials with file name of 'credentials.json'.
-	//
-	// If omitted, client will use the default application credentials.
+	// The name of the secret object that stores the Ceph RGW credential:
+	// JSON credentials with file name of 'credentials.json', holding the
+	// "access_key" and "secret_key" of the RGW user.
 	CephSecret string `json:"cephSecret,omitempty"`
+
+	// Endpoint is the URL of the Ceph RADOS gateway, e.g:
+	// "http://ceph-rgw.ceph.svc.cluster.local:8088"
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+// LocalBackupSource provides the spec how to store backups on a filesystem.
+type LocalBackupSource struct {
+	// Path is the path of the backup file. It is relative to the root of the
+	// volume when PersistentVolumeClaim is set, e.g: "armada/armada.backup"
+	Path string `json:"path"`
+
+	// PersistentVolumeClaim is the name of the claim holding the backups, in
+	// the namespace of the backup. It is mounted at LocalVolumeMountPath.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
 }
 
 // BackupPolicy defines backup policy.
@@ -117,9 +135,11 @@ type ArmadaBackupStatus struct {
 	ArmadaStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
-// ArmadaBackup is the Schema for the armadabackups API
+// ArmadaBackup is the Schema for the armadabackups API. The backup of the
+// charts is an archive in the format of package pkg/backup/archive.
 // +k8s:openapi-gen=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=armadabackups,shortName=abck
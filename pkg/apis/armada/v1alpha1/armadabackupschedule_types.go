@@ -0,0 +1,99 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Labels and annotations set on the ArmadaBackups created by a schedule
+const (
+	// BackupScheduleLabel holds the name of the ArmadaBackupSchedule
+	BackupScheduleLabel = "armada.airshipit.org/backup-schedule"
+	// BackupScheduledAtAnnotation holds the scheduled time of the run, in RFC3339
+	BackupScheduledAtAnnotation = "armada.airshipit.org/scheduled-at"
+)
+
+// ArmadaBackupScheduleSpec defines the desired state of ArmadaBackupSchedule
+type ArmadaBackupScheduleSpec struct {
+	// Schedule in Cron format, e.g: "0 2 * * *". The descriptors such as
+	// "@daily" and a leading "CRON_TZ=<time zone>" are accepted.
+	Schedule string `json:"schedule"`
+	// Suspend stops the creation of new backups. The existing ones are
+	// still expired according to the retention.
+	Suspend bool `json:"suspend,omitempty"`
+	// Template is the spec of the ArmadaBackup created at each run. The
+	// scheduled time of the run is inserted in the path of the backup file,
+	// e.g: "mybucket/armada.backup" becomes "mybucket/armada-20190602T020000Z.backup"
+	Template ArmadaBackupSpec `json:"template"`
+	// Retention configures the expiry of the backups created by the schedule.
+	// The backups are kept forever when not set.
+	Retention *BackupRetention `json:"retention,omitempty"`
+}
+
+// BackupRetention defines which backups of a schedule are kept
+type BackupRetention struct {
+	// MaxCount is the number of finished backups kept, the oldest ones
+	// being expired first. Zero means no limit. The backup files of the
+	// expired backups are deleted with them.
+	MaxCount int32 `json:"maxCount,omitempty"`
+	// MaxAge is the age after which a finished backup is expired, e.g: "720h"
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// ArmadaBackupScheduleStatus defines the observed state of ArmadaBackupSchedule
+type ArmadaBackupScheduleStatus struct {
+	// LastScheduleTime is the scheduled time of the last run
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastBackup is the name of the ArmadaBackup created by the last run
+	LastBackup string `json:"lastBackup,omitempty"`
+	// NextScheduleTime is the scheduled time of the next run. It is not
+	// set while the schedule is suspended.
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// ObservedGeneration is the generation of the spec the status reflects
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaBackupSchedule is the Schema for the armadabackupschedules API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=armadabackupschedules,shortName=abcks
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=".spec.schedule",description="Schedule"
+// +kubebuilder:printcolumn:name="Suspend",type="boolean",JSONPath=".spec.suspend",description="Suspend"
+// +kubebuilder:printcolumn:name="Last Schedule",type="date",JSONPath=".status.lastScheduleTime",description="Last Schedule"
+// +kubebuilder:printcolumn:name="Next Schedule",type="date",JSONPath=".status.nextScheduleTime",description="Next Schedule"
+type ArmadaBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArmadaBackupScheduleSpec   `json:"spec,omitempty"`
+	Status ArmadaBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaBackupScheduleList contains a list of ArmadaBackupSchedule
+type ArmadaBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArmadaBackupSchedule `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArmadaBackupSchedule{}, &ArmadaBackupScheduleList{})
+}
@@ -0,0 +1,92 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/pruning"
+	"sigs.k8s.io/yaml"
+)
+
+// TestArmadaChartCRDValuesPruning runs an ArmadaChart through the pruning
+// of the API server with the schema of the generated CRD. The values of a
+// chart must reach the controller whatever their shape.
+func TestArmadaChartCRDValuesPruning(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	blob, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "kubectl", "armada.airshipit.org_armadacharts.yaml"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	g.Expect(yaml.Unmarshal(blob, crd)).To(gomega.Succeed())
+
+	var props *apiextensionsv1.JSONSchemaProps
+	for _, version := range crd.Spec.Versions {
+		if version.Name == "v1alpha1" {
+			props = version.Schema.OpenAPIV3Schema
+		}
+	}
+	g.Expect(props).NotTo(gomega.BeNil())
+	internal := &apiextensions.JSONSchemaProps{}
+	g.Expect(apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(props, internal, nil)).To(gomega.Succeed())
+	schema, err := structuralschema.NewStructural(internal)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// No nested schema, so no key is validated against ArmadaChartValues
+	values := schema.Properties["spec"].Properties["values"]
+	g.Expect(values.Type).To(gomega.Equal("object"))
+	g.Expect(values.XPreserveUnknownFields).To(gomega.BeTrue())
+	g.Expect(values.Properties).To(gomega.BeEmpty())
+
+	obj := map[string]interface{}{
+		"apiVersion": "armada.airshipit.org/v1alpha1",
+		"kind":       "ArmadaChart",
+		"metadata":   map[string]interface{}{"name": "keystone"},
+		"spec": map[string]interface{}{
+			"chart_name": "keystone",
+			"unknown":    "pruned",
+			"values": map[string]interface{}{
+				"images": map[string]interface{}{
+					"tags":           map[string]interface{}{"api": "api:1.0"},
+					"local_registry": map[string]interface{}{"active": true},
+				},
+				"pod": map[string]interface{}{
+					"custom_nested": map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+				},
+				"replicas": map[string]interface{}{"api": "two"},
+			},
+		},
+	}
+	pruning.Prune(obj, schema, true)
+
+	spec := obj["spec"].(map[string]interface{})
+	g.Expect(spec).NotTo(gomega.HaveKey("unknown"))
+	g.Expect(spec["values"]).To(gomega.Equal(map[string]interface{}{
+		"images": map[string]interface{}{
+			"tags":           map[string]interface{}{"api": "api:1.0"},
+			"local_registry": map[string]interface{}{"active": true},
+		},
+		"pod": map[string]interface{}{
+			"custom_nested": map[string]interface{}{"a": map[string]interface{}{"b": "c"}},
+		},
+		"replicas": map[string]interface{}{"api": "two"},
+	}))
+}
@@ -17,7 +17,6 @@ package v1alpha1
 import (
 	"fmt"
 	"reflect"
-	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -110,6 +109,11 @@ type ArmadaChartValues struct {
 	Storageclass *runtime.RawExtension `json:"storageclass,omitempty"`
 	// volume contains tbd
 	Volume *AVVolume `json:"volume,omitempty"`
+
+	// Unknown holds the raw values of the keys the fields above can not
+	// represent: the keys they do not model and the ones whose value does
+	// not survive a round trip through their type. See UnmarshalJSON.
+	Unknown map[string]runtime.RawExtension `json:"-"`
 }
 
 // ======= ArmadaChartSpec Definition =======
@@ -127,6 +131,9 @@ type ArmadaChartSpec struct {
 	Dependencies []string `json:"dependencies"`
 
 	// override any default values in the charts
+	// +kubebuilder:validation:Schemaless
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
 	Values *ArmadaChartValues `json:"values,omitempty"`
 	// See Delete_.
 	Delete *ArmadaDelete `json:"delete,omitempty"`
@@ -146,6 +153,11 @@ type ArmadaChartSpec struct {
 	// Target state of the Helm Custom Resources
 	TargetState HelmResourceState `json:"target_state"`
 
+	// Library marks a chart, such as helm-toolkit, which only provides
+	// templates to the other charts and is never deployed on its own.
+	// Its target state defaults to uninitialized and can not be deployed.
+	Library bool `json:"library,omitempty"`
+
 	// revisionHistoryLimit is the maximum number of revisions that will
 	// be maintained in the ArmadaChart's revision history. The revision history
 	// consists of all revisions not represented by a currently applied
@@ -160,10 +172,12 @@ type ArmadaChartStatus struct {
 }
 
 // ======= ArmadaChartList Definition =======
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ArmadaChart is the Schema for the armadacharts API
 // +k8s:openapi-gen=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=armadacharts,shortName=act
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actual_state",description="State"
@@ -177,23 +191,6 @@ type ArmadaChart struct {
 	Status ArmadaChartStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an ArmadaChart. Namely, if the state has not been
-// specified, it will be set
-func (obj *ArmadaChart) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		// TODO(JEB): Big temporary kludge to deal with helm-toolkit
-		if strings.Contains(obj.Name, "-htk") {
-			obj.Spec.TargetState = StateUninitialized
-		} else {
-			obj.Spec.TargetState = StateDeployed
-		}
-	}
-	obj.Status.Satisfied = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *ArmadaChart) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -245,6 +242,11 @@ func (obj *ArmadaChart) IsTargetStateUninitialized() bool {
 	return obj.Spec.TargetState == StateUninitialized
 }
 
+// IsLibrary returns true if the chart only provides templates to other charts
+func (obj *ArmadaChart) IsLibrary() bool {
+	return obj.Spec.Library
+}
+
 // IsSatisfied returns true if the chart's actual state meets its target state
 func (obj *ArmadaChart) IsSatisfied() bool {
 	return obj.Spec.TargetState == obj.Status.ActualState
@@ -371,19 +373,32 @@ func (obj *ArmadaCharts) GetName() string {
 	return obj.Name
 }
 
-// Loop through the Chart and return the first disabled one
+// GetChartsToEnable returns the disabled charts whose dependencies are all
+// ready, see ChartGraph. When sequenced is set, a chart also waits for the
+// one listed before it.
+func (obj *ArmadaCharts) GetChartsToEnable(sequenced bool) ([]*ArmadaChart, error) {
+	graph, err := NewChartGraph(obj.List.Items, sequenced)
+	if err != nil {
+		return nil, err
+	}
+	return graph.ChartsToEnable(), nil
+}
+
+// GetNextToEnable returns the first chart which can be enabled when the
+// charts are deployed one after the other, or nil if none can be. An
+// invalid graph, a dependency cycle for instance, is logged and returns nil.
+//
+// Deprecated: use GetChartsToEnable(true), which returns the error.
 func (obj *ArmadaCharts) GetNextToEnable() *ArmadaChart {
-	for _, act := range obj.List.Items {
-		if !act.IsTargetStateUninitialized() && !act.IsReady() {
-			// The Chart has been enabled but is still deploying
-			return nil
-		}
-		if act.IsTargetStateUninitialized() {
-			// The Chart has not been enabled yet
-			return &act
-		}
+	charts, err := obj.GetChartsToEnable(true)
+	if err != nil {
+		tlog.Error(err, "Can't compute the next chart to enable", "name", obj.Name)
+		return nil
+	}
+	if len(charts) == 0 {
+		return nil
 	}
-	return nil
+	return charts[0]
 }
 
 // Loop through the charts and return all the disabled ones
@@ -392,7 +407,7 @@ func (obj *ArmadaCharts) GetAllDisabledCharts() *ArmadaCharts {
 	var res = NewArmadaCharts(obj.Name)
 
 	for _, act := range obj.List.Items {
-		if act.IsTargetStateUninitialized() {
+		if act.IsTargetStateUninitialized() && !act.IsLibrary() {
 			// The Chart has not been enabled yet
 			res.List.Items = append(res.List.Items, act)
 		}
@@ -405,7 +420,7 @@ func (obj *ArmadaCharts) GetAllDisabledCharts() *ArmadaCharts {
 func (obj *ArmadaCharts) IsReady() bool {
 
 	for _, act := range obj.List.Items {
-		if !act.IsReady() {
+		if !act.IsReady() && !act.IsLibrary() {
 			// The Chart is not ready so the list is not
 			return false
 		}
@@ -0,0 +1,117 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// armadaChartValues has the fields of ArmadaChartValues but not its
+// methods, which avoids the recursion in MarshalJSON and UnmarshalJSON.
+type armadaChartValues ArmadaChartValues
+
+// UnmarshalJSON decodes the values key by key. A key is decoded in its
+// typed field only if the field encodes it back to the same value; all the
+// other keys are kept as is in Unknown. Nothing the chart receives is lost,
+// whatever the shape of its values.
+func (v *ArmadaChartValues) UnmarshalJSON(data []byte) error {
+	raw := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	res := armadaChartValues{}
+	for k, value := range raw {
+		single, err := json.Marshal(map[string]json.RawMessage{k: value})
+		if err != nil {
+			return err
+		}
+		field := armadaChartValues{}
+		if json.Unmarshal(single, &field) == nil && encodesKey(&field, k, value) {
+			// Can not fail: the very same document just decoded
+			_ = json.Unmarshal(single, &res)
+			continue
+		}
+		if res.Unknown == nil {
+			res.Unknown = make(map[string]runtime.RawExtension)
+		}
+		res.Unknown[k] = runtime.RawExtension{Raw: append([]byte(nil), value...)}
+	}
+	*v = ArmadaChartValues(res)
+	return nil
+}
+
+// encodesKey returns true if field encodes the key k to value
+func encodesKey(field *armadaChartValues, k string, value json.RawMessage) bool {
+	data, err := json.Marshal(field)
+	if err != nil {
+		return false
+	}
+	encoded := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &encoded); err != nil {
+		return false
+	}
+	typed, ok := encoded[k]
+	if !ok {
+		return false
+	}
+	var a, b interface{}
+	if json.Unmarshal(typed, &a) != nil || json.Unmarshal(value, &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// MarshalJSON encodes the typed fields and the keys held in Unknown. A key
+// held in Unknown overrides the typed field of the same name.
+func (v ArmadaChartValues) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(armadaChartValues(v))
+	if err != nil || len(v.Unknown) == 0 {
+		return data, err
+	}
+	res := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	for k, value := range v.Unknown {
+		if value.Raw == nil {
+			res[k] = json.RawMessage("null")
+			continue
+		}
+		res[k] = json.RawMessage(value.Raw)
+	}
+	return json.Marshal(res)
+}
+
+// ToMap converts the values into the raw map helm receives
+func (v *ArmadaChartValues) ToMap() (map[string]interface{}, error) {
+	if v == nil {
+		return make(map[string]interface{}), nil
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(v)
+}
+
+// NewArmadaChartValues converts a raw helm values map. Every key is kept,
+// see UnmarshalJSON.
+func NewArmadaChartValues(values map[string]interface{}) (*ArmadaChartValues, error) {
+	res := &ArmadaChartValues{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(values, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
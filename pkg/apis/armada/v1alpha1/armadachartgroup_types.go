@@ -51,18 +51,26 @@ type ArmadaChartGroupSpec struct {
 // ArmadaChartGroupStatus defines the observed state of ArmadaChartGroup
 type ArmadaChartGroupStatus struct {
 	ArmadaStatus `json:",inline"`
+
+	// Children summarizes the states of the charts, see AggregateStatus
+	Children *ChildrenStatus `json:"children,omitempty"`
 }
 
 // ======= ArmadaChartGroup Definition =======
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ArmadaChartGroup is the Schema for the armadachartgroups API
 // +k8s:openapi-gen=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=armadachartgroups,shortName=acg
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actual_state",description="State"
 // +kubebuilder:printcolumn:name="Target State",type="string",JSONPath=".spec.target_state",description="Target State"
 // +kubebuilder:printcolumn:name="Satisfied",type="boolean",JSONPath=".status.satisfied",description="Satisfied"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.children.progress",description="Percentage of ready charts"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.children.failed",description="Number of failed charts"
+// +kubebuilder:printcolumn:name="Blocking",type="string",JSONPath=".status.children.blocking",description="First chart not ready"
 type ArmadaChartGroup struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -71,21 +79,6 @@ type ArmadaChartGroup struct {
 	Status ArmadaChartGroupStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an ArmadaChart. Namely, if the state has not been
-// specified, it will be set
-func (obj *ArmadaChartGroup) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	if obj.Spec.Charts == nil {
-		obj.Spec.Charts = make([]string, 0)
-	}
-	obj.Status.Satisfied = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *ArmadaChartGroup) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -46,18 +46,26 @@ type ArmadaManifestSpec struct {
 // ArmadaManifestStatus defines the observed state of ArmadaManifest
 type ArmadaManifestStatus struct {
 	ArmadaStatus `json:",inline"`
+
+	// Children summarizes the states of the chart groups, see AggregateStatus
+	Children *ChildrenStatus `json:"children,omitempty"`
 }
 
 // ======= ArmadaManifest Definition =======
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ArmadaManifest is the Schema for the armadamanifests API
 // +k8s:openapi-gen=true
+// +kubebuilder:storageversion
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=armadamanifests,shortName=amf
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actual_state",description="State"
 // +kubebuilder:printcolumn:name="Target State",type="string",JSONPath=".spec.target_state",description="Target State"
 // +kubebuilder:printcolumn:name="Satisfied",type="boolean",JSONPath=".status.satisfied",description="Satisfied"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.children.progress",description="Percentage of ready chart groups"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.children.failed",description="Number of failed chart groups"
+// +kubebuilder:printcolumn:name="Blocking",type="string",JSONPath=".status.children.blocking",description="First chart group not ready"
 type ArmadaManifest struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -66,19 +74,6 @@ type ArmadaManifest struct {
 	Status ArmadaManifestStatus `json:"status,omitempty"`
 }
 
-func (obj *ArmadaManifest) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	if obj.Spec.ChartGroups == nil {
-		obj.Spec.ChartGroups = make([]string, 0)
-	}
-	obj.Status.Satisfied = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *ArmadaManifest) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -17,11 +17,57 @@ type ArmadaRestoreSpec struct {
 	Charts []string `json:"charts,omitempty"`
 	// Target state of the Helm Custom Resources
 	TargetState HelmResourceState `json:"targetState,omitempty"`
+
+	// DryRun verifies the backup and records in status.plan what the
+	// restore would do, without changing anything.
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
 // ArmadaRestoreStatus defines the observed state of ArmadaRestore
 type ArmadaRestoreStatus struct {
 	ArmadaStatus `json:",inline"`
+
+	// Plan is what the restore does, or would do for a dry run
+	Plan *RestorePlan `json:"plan,omitempty"`
+}
+
+// RestoreAction is what a restore does to a chart or a release
+type RestoreAction string
+
+// Actions of a restore
+const (
+	// RestoreActionCreate creates what is missing from the cluster
+	RestoreActionCreate RestoreAction = "create"
+	// RestoreActionReplace replaces what differs from the backup
+	RestoreActionReplace RestoreAction = "replace"
+	// RestoreActionLeave leaves alone what matches the backup or is not restored
+	RestoreActionLeave RestoreAction = "leave"
+)
+
+// RestorePlan lists the actions of a restore
+type RestorePlan struct {
+	// Backup is the name of the ArmadaBackup the archive was taken for
+	Backup string `json:"backup,omitempty"`
+	// Created is the time the archive was written
+	Created *metav1.Time `json:"created,omitempty"`
+	// FormatVersion is the version of the archive format
+	FormatVersion int `json:"formatVersion,omitempty"`
+	// Charts are the actions on the ArmadaCharts
+	Charts []RestorePlanItem `json:"charts,omitempty"`
+	// Releases are the actions on the helm releases
+	Releases []RestorePlanItem `json:"releases,omitempty"`
+	// Errors are the problems found in the backup. Nothing is restored
+	// while there is any.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// RestorePlanItem is the action of a restore on a chart or a release
+type RestorePlanItem struct {
+	Name      string        `json:"name"`
+	Namespace string        `json:"namespace,omitempty"`
+	Action    RestoreAction `json:"action"`
+	// Reason explains the action
+	Reason string `json:"reason,omitempty"`
 }
 
 type RestoreSource struct {
@@ -30,6 +76,9 @@ type RestoreSource struct {
 
 	// Ceph tells where on Ceph the backup is saved and how to fetch the backup.
 	Ceph *CephRestoreSource `json:"ceph,omitempty"`
+
+	// Local tells where on a filesystem the backup is saved.
+	Local *LocalRestoreSource `json:"local,omitempty"`
 }
 
 type OffsiteRestoreSource struct {
@@ -62,15 +111,27 @@ type CephRestoreSource struct {
 	// e.g: "mycephbucket/armada.backup"
 	Path string `json:"path"`
 
-	// The name of the secret object that stores the Google storage credential
-	// containing at most ONE of the following:
-	// An access token with file name of 'access-token'.
-	// JSON credentials with file name of 'credentials.json'.
-	//
-	// If omitted, client will use the default application credentials.
+	// The name of the secret object that stores the Ceph RGW credential:
+	// JSON credentials with file name of 'credentials.json', holding the
+	// "access_key" and "secret_key" of the RGW user.
 	CephSecret string `json:"cephSecret,omitempty"`
+
+	// Endpoint is the URL of the Ceph RADOS gateway, e.g:
+	// "http://ceph-rgw.ceph.svc.cluster.local:8088"
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
+type LocalRestoreSource struct {
+	// Path is the path of the backup file. It is relative to the root of the
+	// volume when PersistentVolumeClaim is set, e.g: "armada/armada.backup"
+	Path string `json:"path"`
+
+	// PersistentVolumeClaim is the name of the claim holding the backups, in
+	// the namespace of the restore. It is mounted at LocalVolumeMountPath.
+	PersistentVolumeClaim string `json:"persistentVolumeClaim,omitempty"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ArmadaRestore is the Schema for the armadarestores API
@@ -15,9 +15,10 @@
 package v1alpha1
 
 import (
+	"github.com/keleustes/armada-crd/pkg/lifecycle"
 	yaml "gopkg.in/yaml.v2"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // HelmResourceState is the status of a release/chart/chartgroup/manifest
@@ -112,6 +113,10 @@ type HelmResourceCondition struct {
 	ResourceName       string                      `json:"resourceName,omitempty"`
 	ResourceVersion    int32                       `json:"resourceVersion,omitempty"`
 	LastTransitionTime metav1.Time                 `json:"lastTransitionTime,omitempty"`
+	// ObservedGeneration is the generation of the spec the condition
+	// was computed from. The field makes the condition a metav1.Condition
+	// on the wire.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 type HelmResourceConditionListHelper struct {
@@ -127,20 +132,24 @@ type ArmadaStatus struct {
 	// Actual state of the Helm Custom Resources
 	ActualState HelmResourceState `json:"actual_state"`
 	// List of conditions and states related to the resource. JEB: Feature kind of overlap with event recorder
+	// Besides the armada specific types, it holds the Ready, Reconciling
+	// and Stalled conditions understood by kubectl wait and kstatus.
 	Conditions []HelmResourceCondition `json:"conditions,omitempty"`
+	// ObservedGeneration is the generation of the spec the status reflects
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // SetCondition sets a condition on the status object. If the condition already
 // exists, it will be replaced. SetCondition does not update the resource in
-// the cluster.
-func (s *ArmadaStatus) SetCondition(cond HelmResourceCondition, tgt HelmResourceState) {
+// the cluster. The condition is recorded even if ComputeActualState rejects it.
+func (s *ArmadaStatus) SetCondition(cond HelmResourceCondition, tgt HelmResourceState) error {
 
 	// Add the condition to the list
 	chelper := HelmResourceConditionListHelper{Items: s.Conditions}
 	s.Conditions = chelper.SetCondition(cond)
 
 	// Recompute the state
-	s.ComputeActualState(cond, tgt)
+	return s.ComputeActualState(cond, tgt)
 }
 
 // RemoveCondition removes the condition with the passed condition type from
@@ -235,99 +244,26 @@ func (s *HelmResourceConditionListHelper) FindCondition(conditionType HelmResour
 	return found
 }
 
-func (s *ArmadaStatus) ComputeActualState(cond HelmResourceCondition, target HelmResourceState) {
-	// TODO(Ian): finish this
-	if cond.Status == ConditionStatusTrue {
-		switch cond.Type {
-		case ConditionPending:
-			s.ActualState = StatePending
-			s.Satisfied = (s.ActualState == target)
-			s.Reason = ""
-		case ConditionInitialized:
-			// Since that condition is set almost systematically
-			// let's do not recompute the state.
-			if (s.ActualState == "") || (s.ActualState == StateUnknown) {
-				s.ActualState = StateInitialized
-				s.Satisfied = (s.ActualState == target)
-				s.Reason = ""
-			}
-		case ConditionRunning:
-			// The deployment is still running
-			s.ActualState = StateRunning
-			s.Satisfied = false
-			s.Reason = ""
-		case ConditionDeployed:
-			// No change is expected anymore. It is deployed
-			s.ActualState = StateDeployed
-			s.Satisfied = (s.ActualState == target)
-			s.Reason = ""
-		case ConditionFailed:
-			// No change is expected anymore. It is failed
-			s.ActualState = StateFailed
-			s.Satisfied = false
-			s.Reason = cond.Reason.String()
-		case ConditionIrreconcilable:
-			// We can't reconcile the subresources and the CRD
-			s.ActualState = StateError
-			s.Satisfied = false
-			s.Reason = cond.Reason.String()
-		case ConditionError:
-			// We have a bug somewhere.
-			s.ActualState = StateError
-			s.Satisfied = false
-			s.Reason = cond.Reason.String()
-		default:
-			s.Satisfied = (s.ActualState == target)
-			s.Reason = ""
-		}
-	} else {
-		if cond.Type == ConditionDeployed {
-			s.ActualState = StateUninstalled
-			s.Satisfied = (s.ActualState == target)
-			s.Reason = ""
-		} else {
-			s.Satisfied = (s.ActualState == target)
-			s.Reason = ""
-		}
-	}
-}
-
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-
-// ControllerRevision implements an immutable snapshot of state data. Clients
-// are responsible for serializing and deserializing the objects that contain
-// their internal state.
-// Once a ControllerRevision has been successfully created, it can not be updated.
-// The API Server will fail validation of all requests that attempt to mutate
-// the Data field. ControllerRevisions may, however, be deleted. Note that, due to its use by both
-// the DaemonSet and StatefulSet controllers for update and rollback, this object is beta. However,
-// it may be subject to name and representation changes in future releases, and clients should not
-// depend on its stability. It is primarily for internal use by controllers.
-type ControllerRevision struct {
-	metav1.TypeMeta `json:",inline"`
-	// Standard object's metadata.
-	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
-	// +optional
-	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
-
-	// Data is the serialized representation of the state.
-	// +k8s:openapi-gen:schema-type-format=object
-	Data runtime.RawExtension `json:"data,omitempty" protobuf:"bytes,2,opt,name=data"`
-
-	// Revision indicates the revision of the state represented by Data.
-	Revision int64 `json:"revision" protobuf:"varint,3,opt,name=revision"`
+// ComputeActualState moves the actual state according to the condition and
+// recomputes Satisfied, see the transition table of the lifecycle package. A
+// condition contradicting the actual state leaves it unchanged and returns
+// a *lifecycle.RejectedTransitionError.
+func (s *ArmadaStatus) ComputeActualState(cond HelmResourceCondition, target HelmResourceState) error {
+	res, err := lifecycle.Compute(
+		lifecycle.State(s.ActualState), s.Reason,
+		lifecycle.ConditionType(cond.Type), lifecycle.ConditionStatus(cond.Status), cond.Reason.String(),
+		lifecycle.State(target))
+	s.ActualState = HelmResourceState(res.State)
+	s.Satisfied = res.Satisfied
+	s.Reason = res.Reason
+	return err
 }
 
-// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
-
-// ControllerRevisionList is a resource containing a list of ControllerRevision objects.
-type ControllerRevisionList struct {
-	metav1.TypeMeta `json:",inline"`
+// ControllerRevision is the apps/v1 ControllerRevision in which package
+// pkg/revision records the history of the specs.
+// +k8s:deepcopy-gen=false
+type ControllerRevision = appsv1.ControllerRevision
 
-	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata
-	// +optional
-	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
-
-	// Items is the list of ControllerRevisions
-	Items []ControllerRevision `json:"items" protobuf:"bytes,2,rep,name=items"`
-}
+// ControllerRevisionList is a list of ControllerRevisions
+// +k8s:deepcopy-gen=false
+type ControllerRevisionList = appsv1.ControllerRevisionList
@@ -68,6 +68,27 @@ func TestComputeActualState(t *testing.T) {
 	testStatus.ComputeActualState(testCondition, StateUninstalled)
 	compareState(t, testStatus, testStatus.ActualState, true, "")
 
+	// Deployed=False only uninstalls what may have been deployed
+	testCondition = HelmResourceCondition{Status: ConditionStatusFalse, Type: ConditionDeployed}
+	testStatus = &ArmadaStatus{ActualState: StateRunning}
+	testStatus.ComputeActualState(testCondition, StateDeployed)
+	compareState(t, testStatus, StateRunning, false, "")
+
+	// A chart may be deployed or fail on its first reconcile, before
+	// Initializing is set
+	testCondition = HelmResourceCondition{Status: ConditionStatusTrue, Type: ConditionDeployed}
+	testStatus = &ArmadaStatus{ActualState: StateUninitialized}
+	if err := testStatus.ComputeActualState(testCondition, StateDeployed); err != nil {
+		t.Errorf("Expected Deployed=True to be accepted in state %s: %v", StateUninitialized, err)
+	}
+	compareState(t, testStatus, StateDeployed, true, "")
+
+	testCondition = HelmResourceCondition{Status: ConditionStatusTrue, Type: ConditionFailed, Reason: "InstallError"}
+	testStatus = &ArmadaStatus{ActualState: StateUninitialized}
+	if err := testStatus.ComputeActualState(testCondition, StateDeployed); err != nil {
+		t.Errorf("Expected Failed=True to be accepted in state %s: %v", StateUninitialized, err)
+	}
+	compareState(t, testStatus, StateFailed, false, "InstallError")
 }
 
 // compareState is a convenience function to check all the results of ComputeActualState
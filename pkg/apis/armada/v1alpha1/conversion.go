@@ -0,0 +1,27 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+// v1alpha1 is the storage version and the hub of the conversions to and
+// from the other versions of the armada API.
+
+// Hub marks ArmadaChart as a conversion hub
+func (*ArmadaChart) Hub() {}
+
+// Hub marks ArmadaChartGroup as a conversion hub
+func (*ArmadaChartGroup) Hub() {}
+
+// Hub marks ArmadaManifest as a conversion hub
+func (*ArmadaManifest) Hub() {}
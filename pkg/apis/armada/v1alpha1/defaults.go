@@ -0,0 +1,102 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/keleustes/armada-crd/pkg/lifecycle"
+)
+
+// The SetDefaults_ functions are collected by defaulter-gen into
+// zz_generated.defaults.go, whose RegisterDefaults is added to the
+// SchemeBuilder. Use scheme.Default(obj) to apply them: the webhook
+// and the clients then share the very same defaulting.
+
+func init() {
+	SchemeBuilder.SchemeBuilder.Register(RegisterDefaults)
+}
+
+// setStatusDefaults initializes a status which has never been reconciled
+func setStatusDefaults(s *ArmadaStatus, target HelmResourceState) {
+	if s.ActualState == "" {
+		s.ActualState = StateUninitialized
+		s.Satisfied = lifecycle.Satisfied(lifecycle.State(s.ActualState), lifecycle.State(target))
+	}
+}
+
+// SetDefaults_ArmadaChart sets the target state of the chart, uninitialized
+// for a library chart and deployed otherwise, and initializes the status.
+func SetDefaults_ArmadaChart(obj *ArmadaChart) {
+	if obj.Spec.TargetState == "" {
+		if obj.Spec.Library {
+			obj.Spec.TargetState = StateUninitialized
+		} else {
+			obj.Spec.TargetState = StateDeployed
+		}
+	}
+	if obj.Spec.Dependencies == nil {
+		obj.Spec.Dependencies = make([]string, 0)
+	}
+	setStatusDefaults(&obj.Status.ArmadaStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_ArmadaChartGroup sets the target state to deployed and
+// initializes the status.
+func SetDefaults_ArmadaChartGroup(obj *ArmadaChartGroup) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	if obj.Spec.Charts == nil {
+		obj.Spec.Charts = make([]string, 0)
+	}
+	setStatusDefaults(&obj.Status.ArmadaStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_ArmadaManifest sets the target state to deployed and
+// initializes the status.
+func SetDefaults_ArmadaManifest(obj *ArmadaManifest) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	if obj.Spec.ChartGroups == nil {
+		obj.Spec.ChartGroups = make([]string, 0)
+	}
+	setStatusDefaults(&obj.Status.ArmadaStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_ArmadaBackup sets the target state to deployed and
+// initializes the status.
+func SetDefaults_ArmadaBackup(obj *ArmadaBackup) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.ArmadaStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_ArmadaRestore sets the target state to deployed and
+// initializes the status.
+func SetDefaults_ArmadaRestore(obj *ArmadaRestore) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.ArmadaStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_ArmadaBackupSchedule sets the target state of the backups
+// to deployed.
+func SetDefaults_ArmadaBackupSchedule(obj *ArmadaBackupSchedule) {
+	if obj.Spec.Template.TargetState == "" {
+		obj.Spec.Template.TargetState = StateDeployed
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSchemeDefaults(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	s := runtime.NewScheme()
+	g.Expect(AddToScheme(s)).NotTo(gomega.HaveOccurred())
+
+	chart := &ArmadaChart{ObjectMeta: metav1.ObjectMeta{Name: "keystone-htk"}}
+	s.Default(chart)
+	g.Expect(chart.Spec.TargetState).To(gomega.Equal(StateDeployed))
+	g.Expect(chart.Spec.Dependencies).NotTo(gomega.BeNil())
+	g.Expect(chart.Status.ActualState).To(gomega.Equal(StateUninitialized))
+	g.Expect(chart.Status.Satisfied).To(gomega.BeFalse())
+
+	library := &ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-toolkit"},
+		Spec:       ArmadaChartSpec{Library: true},
+	}
+	s.Default(library)
+	g.Expect(library.Spec.TargetState).To(gomega.Equal(StateUninitialized))
+	g.Expect(library.Status.Satisfied).To(gomega.BeTrue())
+
+	list := &ArmadaChartGroupList{Items: []ArmadaChartGroup{{}}}
+	s.Default(list)
+	g.Expect(list.Items[0].Spec.TargetState).To(gomega.Equal(StateDeployed))
+	g.Expect(list.Items[0].Spec.Charts).NotTo(gomega.BeNil())
+
+	// Defaulting never overrides what has been set
+	manifest := &ArmadaManifest{Spec: ArmadaManifestSpec{TargetState: StateUninstalled}}
+	s.Default(manifest)
+	g.Expect(manifest.Spec.TargetState).To(gomega.Equal(StateUninstalled))
+
+	schedule := &ArmadaBackupSchedule{}
+	s.Default(schedule)
+	g.Expect(schedule.Spec.Template.TargetState).To(gomega.Equal(StateDeployed))
+}
@@ -0,0 +1,228 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// ChartGraph is the dependency graph of a set of ArmadaCharts. Each chart
+// points to the charts which must be ready before it can be enabled: the
+// charts listed in its Dependencies, the previous chart of a sequenced
+// ArmadaChartGroup and the charts of the previous group of a manifest.
+// Library charts are never deployed and are left out of the graph, as are
+// the dependencies on charts outside of the set.
+// +k8s:deepcopy-gen=false
+type ChartGraph struct {
+	// order is the order in which the charts were listed
+	order   []string
+	charts  map[string]*ArmadaChart
+	prereqs map[string][]string
+}
+
+// NewChartGraph builds the graph of the charts of a single ArmadaChartGroup.
+// When sequenced is set, each chart also waits for the one listed before it.
+func NewChartGraph(charts []ArmadaChart, sequenced bool) (*ChartGraph, error) {
+	g := newChartGraph()
+	names := make([]string, 0, len(charts))
+	for i := range charts {
+		if g.add(&charts[i]) {
+			names = append(names, charts[i].Name)
+		}
+	}
+	g.addDependencies()
+	if sequenced {
+		g.addSequence(names)
+	}
+	return g, g.checkCycles()
+}
+
+// NewManifestChartGraph builds the graph of the charts of an ArmadaManifest.
+// The groups are deployed in order: the charts of a group wait for all the
+// charts of the previous group. A chart listed by several groups belongs to
+// the first one.
+func NewManifestChartGraph(groups []ArmadaChartGroup, charts []ArmadaChart) (*ChartGraph, error) {
+	byName := make(map[string]*ArmadaChart)
+	for i := range charts {
+		byName[charts[i].Name] = &charts[i]
+	}
+
+	g := newChartGraph()
+	previous := make([]string, 0)
+	for _, group := range groups {
+		names := make([]string, 0, len(group.Spec.Charts))
+		for _, name := range group.Spec.Charts {
+			chart, ok := byName[name]
+			if ok && g.add(chart) {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		for _, name := range names {
+			g.prereqs[name] = append(g.prereqs[name], previous...)
+		}
+		if group.Spec.Sequenced {
+			g.addSequence(names)
+		}
+		previous = names
+	}
+	g.addDependencies()
+	return g, g.checkCycles()
+}
+
+func newChartGraph() *ChartGraph {
+	return &ChartGraph{
+		order:   make([]string, 0),
+		charts:  make(map[string]*ArmadaChart),
+		prereqs: make(map[string][]string),
+	}
+}
+
+// add inserts a chart in the graph. It returns false for the library
+// charts and the charts already present.
+func (g *ChartGraph) add(chart *ArmadaChart) bool {
+	if chart.IsLibrary() {
+		return false
+	}
+	if _, ok := g.charts[chart.Name]; ok {
+		return false
+	}
+	g.order = append(g.order, chart.Name)
+	g.charts[chart.Name] = chart
+	return true
+}
+
+func (g *ChartGraph) addDependencies() {
+	for _, name := range g.order {
+		for _, dep := range g.charts[name].Spec.Dependencies {
+			if _, ok := g.charts[dep]; ok {
+				g.prereqs[name] = append(g.prereqs[name], dep)
+			}
+		}
+	}
+}
+
+func (g *ChartGraph) addSequence(names []string) {
+	for i := 1; i < len(names); i++ {
+		g.prereqs[names[i]] = append(g.prereqs[names[i]], names[i-1])
+	}
+}
+
+// checkCycles fails if some charts can not be sorted
+func (g *ChartGraph) checkCycles() error {
+	sorted := make(map[string]bool)
+	for _, wave := range g.Waves() {
+		for _, name := range wave {
+			sorted[name] = true
+		}
+	}
+	if len(sorted) == len(g.order) {
+		return nil
+	}
+
+	waiting := make([]string, 0)
+	for _, name := range g.order {
+		if !sorted[name] {
+			waiting = append(waiting, name)
+		}
+	}
+	sort.Strings(waiting)
+	return fmt.Errorf("dependency cycle between the charts %s", strings.Join(waiting, ", "))
+}
+
+// Waves returns the names of the charts grouped in waves. The charts of a
+// wave only depend on the charts of the previous waves and can be deployed
+// in parallel. Within a wave the charts keep the order they were listed in.
+func (g *ChartGraph) Waves() [][]string {
+	// Kahn's algorithm. The charts involved in a cycle, and the ones
+	// depending on them, would be left out but the constructors reject
+	// such graphs.
+	pending := make(map[string]int)
+	dependents := make(map[string][]string)
+	for _, name := range g.order {
+		for _, prereq := range g.prereqs[name] {
+			pending[name]++
+			dependents[prereq] = append(dependents[prereq], name)
+		}
+	}
+
+	res := make([][]string, 0)
+	wave := make([]string, 0)
+	for _, name := range g.order {
+		if pending[name] == 0 {
+			wave = append(wave, name)
+		}
+	}
+	for len(wave) > 0 {
+		res = append(res, wave)
+		released := make(map[string]bool)
+		for _, name := range wave {
+			for _, dependent := range dependents[name] {
+				pending[dependent]--
+				if pending[dependent] == 0 {
+					released[dependent] = true
+				}
+			}
+		}
+		wave = make([]string, 0)
+		for _, name := range g.order {
+			if released[name] {
+				wave = append(wave, name)
+			}
+		}
+	}
+	return res
+}
+
+// Prerequisites returns the names of the charts which must be ready
+// before the chart can be enabled, in the order they were added.
+func (g *ChartGraph) Prerequisites(name string) []string {
+	res := make([]string, 0, len(g.prereqs[name]))
+	for _, prereq := range g.prereqs[name] {
+		if !slices.Contains(res, prereq) {
+			res = append(res, prereq)
+		}
+	}
+	return res
+}
+
+// ChartsToEnable returns the charts which have not been enabled yet, i.e.
+// whose target state is still uninitialized, and whose prerequisites are
+// all ready. They can be enabled together.
+func (g *ChartGraph) ChartsToEnable() []*ArmadaChart {
+	res := make([]*ArmadaChart, 0)
+	for _, name := range g.order {
+		chart := g.charts[name]
+		if !chart.IsTargetStateUninitialized() {
+			continue
+		}
+		ready := true
+		for _, prereq := range g.prereqs[name] {
+			if !g.charts[prereq].IsReady() {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			res = append(res, chart)
+		}
+	}
+	return res
+}
@@ -0,0 +1,151 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newGraphChart(name string, deps ...string) ArmadaChart {
+	return ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: ArmadaChartSpec{
+			Dependencies: deps,
+			TargetState:  StateUninitialized,
+		},
+	}
+}
+
+func chartNames(charts []*ArmadaChart) []string {
+	res := make([]string, 0, len(charts))
+	for _, chart := range charts {
+		res = append(res, chart.Name)
+	}
+	return res
+}
+
+func TestChartGraphWaves(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	htk := newGraphChart("helm-toolkit")
+	htk.Spec.Library = true
+	charts := []ArmadaChart{
+		newGraphChart("keystone", "helm-toolkit", "mariadb", "memcached"),
+		newGraphChart("mariadb", "helm-toolkit"),
+		newGraphChart("memcached", "helm-toolkit"),
+		newGraphChart("glance", "keystone"),
+		newGraphChart("nova", "keystone", "outside"),
+		htk,
+	}
+
+	graph, err := NewChartGraph(charts, false)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph.Waves()).To(gomega.Equal([][]string{
+		{"mariadb", "memcached"},
+		{"keystone"},
+		{"glance", "nova"},
+	}))
+
+	// keystone is listed before its dependencies
+	_, err = NewChartGraph(charts, true)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	graph, err = NewChartGraph(append(charts[1:3:3], charts[0], charts[3], charts[4], charts[5]), true)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph.Waves()).To(gomega.Equal([][]string{
+		{"mariadb"},
+		{"memcached"},
+		{"keystone"},
+		{"glance"},
+		{"nova"},
+	}))
+
+	_, err = NewChartGraph([]ArmadaChart{newGraphChart("a", "b"), newGraphChart("b", "a"), newGraphChart("c")}, false)
+	g.Expect(err).To(gomega.MatchError("dependency cycle between the charts a, b"))
+}
+
+func TestChartGraphChartsToEnable(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	charts := &ArmadaCharts{List: &ArmadaChartList{Items: []ArmadaChart{
+		newGraphChart("mariadb"),
+		newGraphChart("memcached"),
+		newGraphChart("keystone", "mariadb", "memcached"),
+		newGraphChart("rabbitmq"),
+	}}}
+
+	toEnable, err := charts.GetChartsToEnable(false)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(chartNames(toEnable)).To(gomega.Equal([]string{"mariadb", "memcached", "rabbitmq"}))
+	toEnable, err = charts.GetChartsToEnable(true)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(chartNames(toEnable)).To(gomega.Equal([]string{"mariadb"}))
+
+	// mariadb deployed, memcached still deploying
+	items := charts.List.Items
+	items[0].Spec.TargetState = StateDeployed
+	items[0].Status.ActualState = StateDeployed
+	items[1].Spec.TargetState = StateDeployed
+	items[1].Status.ActualState = StatePending
+	toEnable, _ = charts.GetChartsToEnable(false)
+	g.Expect(chartNames(toEnable)).To(gomega.Equal([]string{"rabbitmq"}))
+	toEnable, _ = charts.GetChartsToEnable(true)
+	g.Expect(toEnable).To(gomega.BeEmpty())
+
+	items[1].Status.ActualState = StateDeployed
+	toEnable, _ = charts.GetChartsToEnable(false)
+	g.Expect(chartNames(toEnable)).To(gomega.Equal([]string{"keystone", "rabbitmq"}))
+	toEnable, _ = charts.GetChartsToEnable(true)
+	g.Expect(chartNames(toEnable)).To(gomega.Equal([]string{"keystone"}))
+
+	// A cycle is an error, not an empty list
+	items[3].Spec.Dependencies = []string{"keystone"}
+	items[2].Spec.Dependencies = append(items[2].Spec.Dependencies, "rabbitmq")
+	_, err = charts.GetChartsToEnable(false)
+	g.Expect(err).To(gomega.MatchError("dependency cycle between the charts keystone, rabbitmq"))
+	g.Expect(charts.GetNextToEnable()).To(gomega.BeNil())
+}
+
+func TestManifestChartGraph(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	groups := []ArmadaChartGroup{
+		{Spec: ArmadaChartGroupSpec{Charts: []string{"mariadb", "memcached"}}},
+		{Spec: ArmadaChartGroupSpec{Charts: []string{"keystone", "glance", "nova"}, Sequenced: true}},
+	}
+	charts := []ArmadaChart{
+		newGraphChart("nova"),
+		newGraphChart("glance"),
+		newGraphChart("keystone"),
+		newGraphChart("memcached"),
+		newGraphChart("mariadb"),
+	}
+
+	graph, err := NewManifestChartGraph(groups, charts)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(graph.Waves()).To(gomega.Equal([][]string{
+		{"mariadb", "memcached"},
+		{"keystone"},
+		{"glance"},
+		{"nova"},
+	}))
+
+	charts[4].Spec.Dependencies = []string{"nova"}
+	_, err = NewManifestChartGraph(groups, charts)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
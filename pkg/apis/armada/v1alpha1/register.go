@@ -38,4 +38,13 @@ var (
 	// owed follow-up alongside the same change in kubedge-operator-base.
 	//nolint:staticcheck // SA1019: scheme.Builder deprecation, migration pending
 	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme is required by the generated clientsets in pkg/client
+	AddToScheme = SchemeBuilder.AddToScheme
 )
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+// It is required by the generated listers in pkg/client.
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
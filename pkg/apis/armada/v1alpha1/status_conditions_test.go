@@ -0,0 +1,78 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeStandardConditions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	status := &ArmadaStatus{}
+	status.SetCondition(HelmResourceCondition{Type: ConditionRunning, Status: ConditionStatusTrue}, StateDeployed)
+	status.ComputeStandardConditions(3)
+	g.Expect(status.ObservedGeneration).To(gomega.Equal(int64(3)))
+	g.Expect(status.IsStandardConditionTrue(ConditionReconciling)).To(gomega.BeTrue())
+	g.Expect(status.IsStandardConditionTrue(ConditionReady)).To(gomega.BeFalse())
+	g.Expect(status.IsStandardConditionTrue(ConditionStalled)).To(gomega.BeFalse())
+	g.Expect(status.GetStandardCondition(ConditionReady).ObservedGeneration).To(gomega.Equal(int64(3)))
+	transition := status.GetStandardCondition(ConditionStalled).LastTransitionTime
+
+	status.SetCondition(HelmResourceCondition{Type: ConditionDeployed, Status: ConditionStatusTrue}, StateDeployed)
+	status.ComputeStandardConditions(4)
+	g.Expect(status.IsStandardConditionTrue(ConditionReady)).To(gomega.BeTrue())
+	g.Expect(status.IsStandardConditionTrue(ConditionReconciling)).To(gomega.BeFalse())
+	g.Expect(status.GetStandardCondition(ConditionStalled).LastTransitionTime).To(gomega.Equal(transition))
+
+	status.SetCondition(HelmResourceCondition{Type: ConditionFailed, Status: ConditionStatusTrue, Reason: ReasonInstallError}, StateDeployed)
+	status.ComputeStandardConditions(5)
+	stalled := status.GetStandardCondition(ConditionStalled)
+	g.Expect(stalled.Status).To(gomega.Equal(metav1.ConditionTrue))
+	g.Expect(stalled.Message).To(gomega.Equal("InstallError"))
+
+	// The list is the one generic tooling reads
+	conditions := ToConditions(status.Conditions)
+	g.Expect(meta.IsStatusConditionTrue(conditions, "Stalled")).To(gomega.BeTrue())
+	g.Expect(FromConditions(conditions)).To(gomega.HaveLen(len(status.Conditions)))
+	blob, err := json.Marshal(status.Conditions)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	var decoded []metav1.Condition
+	g.Expect(json.Unmarshal(blob, &decoded)).To(gomega.Succeed())
+	g.Expect(meta.FindStatusCondition(decoded, "Ready").ObservedGeneration).To(gomega.Equal(int64(5)))
+}
+
+func TestMigrateConditions(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	status := &ArmadaStatus{
+		ActualState: StateDeployed,
+		Satisfied:   true,
+		Conditions: []HelmResourceCondition{
+			{Type: ConditionDeployed, Status: ConditionStatusTrue, ResourceName: "blog-1"},
+		},
+	}
+	g.Expect(status.MigrateConditions(2)).To(gomega.BeTrue())
+	g.Expect(status.IsStandardConditionTrue(ConditionReady)).To(gomega.BeTrue())
+	g.Expect(status.Conditions).To(gomega.HaveLen(4))
+	g.Expect(status.Conditions[0].ResourceName).To(gomega.Equal("blog-1"))
+	g.Expect(status.MigrateConditions(3)).To(gomega.BeFalse())
+	g.Expect(status.ObservedGeneration).To(gomega.Equal(int64(2)))
+}
@@ -0,0 +1,305 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ValuesLayer is one set of chart values, for instance the values of the
+// chart, the overrides of a site or a list of --set expressions.
+// +k8s:deepcopy-gen=false
+type ValuesLayer struct {
+	// Name identifies the layer in MergedValues.Origins
+	Name   string
+	Values map[string]interface{}
+}
+
+// MergedValues is the result of MergeValuesLayers
+// +k8s:deepcopy-gen=false
+type MergedValues struct {
+	Values map[string]interface{}
+	// Origins maps the dotted path of each leaf of Values to the name of
+	// the layer which set it. Lists are leaves: they are replaced, never
+	// merged.
+	Origins map[string]string
+}
+
+// NewChartValuesLayer converts ArmadaChartValues into a layer
+func NewChartValuesLayer(name string, values *ArmadaChartValues) (ValuesLayer, error) {
+	u, err := values.ToMap()
+	if err != nil {
+		return ValuesLayer{Name: name}, err
+	}
+	return ValuesLayer{Name: name, Values: u}, nil
+}
+
+// NewSetValuesLayer parses a list of --set expressions into a layer. Each
+// expression is a comma separated list of path=value, see ParseSetValues.
+func NewSetValuesLayer(name string, exprs []string) (ValuesLayer, error) {
+	layer := ValuesLayer{Name: name, Values: make(map[string]interface{})}
+	for _, expr := range exprs {
+		if err := ParseSetValues(expr, layer.Values); err != nil {
+			return layer, err
+		}
+	}
+	return layer, nil
+}
+
+// MergeValuesLayers deep merges the layers in order, the last one winning,
+// with the semantics of helm:
+//   - maps are merged key by key,
+//   - a null value deletes the key,
+//   - any other value, lists included, replaces the previous one.
+//
+// The values must be JSON compatible, as returned by the unstructured
+// converter. The layers are not modified.
+func MergeValuesLayers(layers ...ValuesLayer) *MergedValues {
+	res := &MergedValues{
+		Values:  make(map[string]interface{}),
+		Origins: make(map[string]string),
+	}
+	for _, layer := range layers {
+		res.merge(res.Values, layer.Values, nil, layer.Name)
+	}
+	return res
+}
+
+func (m *MergedValues) merge(dst map[string]interface{}, src map[string]interface{}, path []string, layer string) {
+	for k, v := range src {
+		p := append(path[:len(path):len(path)], k)
+		if v == nil {
+			delete(dst, k)
+			m.forget(p)
+			continue
+		}
+		srcMap, srcIsMap := v.(map[string]interface{})
+		dstMap, dstIsMap := dst[k].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			m.merge(dstMap, srcMap, p, layer)
+			continue
+		}
+		m.forget(p)
+		if srcIsMap {
+			// Nulls are dropped from a new map, there is nothing to delete
+			dstMap = make(map[string]interface{})
+			m.merge(dstMap, srcMap, p, layer)
+			if len(dstMap) == 0 {
+				m.Origins[valuesPath(p)] = layer
+			}
+			dst[k] = dstMap
+			continue
+		}
+		dst[k] = runtime.DeepCopyJSONValue(v)
+		m.Origins[valuesPath(p)] = layer
+	}
+}
+
+// forget removes the origins of a path and of everything below it
+func (m *MergedValues) forget(path []string) {
+	prefix := valuesPath(path)
+	for k := range m.Origins {
+		if k == prefix || strings.HasPrefix(k, prefix+".") {
+			delete(m.Origins, k)
+		}
+	}
+}
+
+// ChartValues converts the merged values back to ArmadaChartValues
+func (m *MergedValues) ChartValues() (*ArmadaChartValues, error) {
+	return NewArmadaChartValues(m.Values)
+}
+
+// Paths returns the paths of Origins, sorted
+func (m *MergedValues) Paths() []string {
+	res := make([]string, 0, len(m.Origins))
+	for k := range m.Origins {
+		res = append(res, k)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// valuesPath joins the keys of a path with dots, escaping the dots of
+// the keys as ParseSetValues expects them.
+func valuesPath(path []string) string {
+	keys := make([]string, 0, len(path))
+	for _, k := range path {
+		keys = append(keys, strings.ReplaceAll(k, ".", `\.`))
+	}
+	return strings.Join(keys, ".")
+}
+
+// ParseSetValues parses a helm --set expression, such as
+// "images.tags.nova=nova:latest,pod.replicas.api=2", into values.
+//
+// The keys of a path are separated by dots, a literal dot being escaped
+// as "\.". A key may be followed by an index, as in "hosts[1]=b", to set
+// an element of a list. A value between braces, as in "{a,b}", is a list.
+// The values true, false and null and the integers are converted, use
+// quotes to keep a string; anything else is a string.
+func ParseSetValues(expr string, values map[string]interface{}) error {
+	for _, assignment := range splitUnescaped(expr, ',') {
+		if assignment == "" {
+			continue
+		}
+		eq := strings.Index(assignment, "=")
+		if eq < 0 {
+			return fmt.Errorf("%q: missing '=' in %q", expr, assignment)
+		}
+		path, err := parseValuesPath(assignment[:eq])
+		if err != nil {
+			return fmt.Errorf("%q: %v", expr, err)
+		}
+		setValue(values, path, parseValue(assignment[eq+1:]))
+	}
+	return nil
+}
+
+// splitUnescaped splits s on sep, ignoring the escaped separators and
+// the ones between braces.
+func splitUnescaped(s string, sep byte) []string {
+	res := make([]string, 0)
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '{':
+			depth++
+		case '}':
+			if depth > 0 {
+				depth--
+			}
+		case sep:
+			if depth == 0 {
+				res = append(res, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(res, s[start:])
+}
+
+// maxIndex bounds the lists created by an index in a --set path
+const maxIndex = 65536
+
+// pathElement is a key of a --set path, optionally indexing a list
+type pathElement struct {
+	key   string
+	index int
+}
+
+func parseValuesPath(s string) ([]pathElement, error) {
+	res := make([]pathElement, 0)
+	for _, part := range splitUnescaped(s, '.') {
+		elem := pathElement{index: -1}
+		if open := strings.LastIndex(part, "["); open >= 0 && strings.HasSuffix(part, "]") {
+			index, err := strconv.Atoi(part[open+1 : len(part)-1])
+			if err != nil || index < 0 || index > maxIndex {
+				return nil, fmt.Errorf("invalid index in %q", part)
+			}
+			elem.index = index
+			part = part[:open]
+		}
+		elem.key = unescape(part)
+		if elem.key == "" {
+			return nil, fmt.Errorf("empty key in %q", s)
+		}
+		res = append(res, elem)
+	}
+	return res, nil
+}
+
+func parseValue(s string) interface{} {
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		res := make([]interface{}, 0)
+		inner := s[1 : len(s)-1]
+		if inner == "" {
+			return res
+		}
+		for _, item := range splitUnescaped(inner, ',') {
+			res = append(res, parseValue(item))
+		}
+		return res
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		return unescape(s[1 : len(s)-1])
+	}
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil && (s == "0" || s[0] != '0') {
+		return i
+	}
+	return unescape(s)
+}
+
+func unescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+1 < len(s) {
+			i++
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+func setValue(values map[string]interface{}, path []pathElement, value interface{}) {
+	elem := path[0]
+	last := len(path) == 1
+
+	if elem.index < 0 {
+		if last {
+			values[elem.key] = value
+			return
+		}
+		child, ok := values[elem.key].(map[string]interface{})
+		if !ok {
+			child = make(map[string]interface{})
+			values[elem.key] = child
+		}
+		setValue(child, path[1:], value)
+		return
+	}
+
+	list, _ := values[elem.key].([]interface{})
+	for len(list) <= elem.index {
+		list = append(list, nil)
+	}
+	values[elem.key] = list
+	if last {
+		list[elem.index] = value
+		return
+	}
+	child, ok := list[elem.index].(map[string]interface{})
+	if !ok {
+		child = make(map[string]interface{})
+		list[elem.index] = child
+	}
+	setValue(child, path[1:], value)
+}
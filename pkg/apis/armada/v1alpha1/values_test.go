@@ -0,0 +1,138 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+)
+
+func TestParseSetValues(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	values := make(map[string]interface{})
+	g.Expect(ParseSetValues(`pod.replicas.api=2,images.tags.nova=nova:1.0,debug=true,name="007"`, values)).To(gomega.Succeed())
+	g.Expect(ParseSetValues(`conf.nova\.conf.x=a\,b,hosts={a,b},list[1].name=c,gone=null`, values)).To(gomega.Succeed())
+	g.Expect(values).To(gomega.Equal(map[string]interface{}{
+		"pod":    map[string]interface{}{"replicas": map[string]interface{}{"api": int64(2)}},
+		"images": map[string]interface{}{"tags": map[string]interface{}{"nova": "nova:1.0"}},
+		"debug":  true,
+		"name":   "007",
+		"conf":   map[string]interface{}{"nova.conf": map[string]interface{}{"x": "a,b"}},
+		"hosts":  []interface{}{"a", "b"},
+		"list":   []interface{}{nil, map[string]interface{}{"name": "c"}},
+		"gone":   nil,
+	}))
+
+	g.Expect(ParseSetValues("a.b", values)).NotTo(gomega.Succeed())
+	g.Expect(ParseSetValues("a..b=c", values)).NotTo(gomega.Succeed())
+	g.Expect(ParseSetValues("a[x]=c", values)).NotTo(gomega.Succeed())
+}
+
+func TestMergeValuesLayers(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	base, err := NewChartValuesLayer("chart", &ArmadaChartValues{
+		Images: &AVImages{
+			Tags:       map[string]string{"api": "api:1.0", "db_sync": "db:1.0"},
+			PullPolicy: "IfNotPresent",
+		},
+		CommandPrefix: []string{"a", "b"},
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	site := ValuesLayer{Name: "site", Values: map[string]interface{}{
+		"images": map[string]interface{}{
+			"tags": map[string]interface{}{"api": "api:2.0", "db_sync": nil},
+		},
+		"command_prefix": []interface{}{"c"},
+	}}
+	set, err := NewSetValuesLayer("set", []string{"images.pull_policy=Always", "images.tags.bootstrap=boot:1.0"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	merged := MergeValuesLayers(base, site, set)
+	g.Expect(merged.Origins).To(gomega.Equal(map[string]string{
+		"images.tags.api":       "site",
+		"images.tags.bootstrap": "set",
+		"images.pull_policy":    "set",
+		"command_prefix":        "site",
+	}))
+	g.Expect(merged.Paths()).To(gomega.Equal([]string{
+		"command_prefix",
+		"images.pull_policy",
+		"images.tags.api",
+		"images.tags.bootstrap",
+	}))
+
+	values, err := merged.ChartValues()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values.Images.Tags).To(gomega.Equal(map[string]string{"api": "api:2.0", "bootstrap": "boot:1.0"}))
+	g.Expect(values.Images.PullPolicy).To(gomega.Equal("Always"))
+	g.Expect(values.CommandPrefix).To(gomega.Equal([]string{"c"}))
+
+	// The layers are left untouched
+	g.Expect(site.Values["images"].(map[string]interface{})["tags"]).To(gomega.HaveKeyWithValue("db_sync", gomega.BeNil()))
+	g.Expect(base.Values["images"].(map[string]interface{})["tags"]).To(gomega.HaveKeyWithValue("db_sync", "db:1.0"))
+
+	// A scalar replaces a map and the other way around
+	merged = MergeValuesLayers(site, ValuesLayer{Name: "flat", Values: map[string]interface{}{"images": "none"}})
+	g.Expect(merged.Origins).To(gomega.Equal(map[string]string{"images": "flat", "command_prefix": "site"}))
+	merged = MergeValuesLayers(ValuesLayer{Name: "flat", Values: merged.Values}, site)
+	g.Expect(merged.Origins).To(gomega.Equal(map[string]string{"images.tags.api": "site", "command_prefix": "site"}))
+	g.Expect(merged.Values["images"]).To(gomega.Equal(map[string]interface{}{"tags": map[string]interface{}{"api": "api:2.0"}}))
+}
+
+func TestArmadaChartValuesRoundTrip(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	raw := map[string]interface{}{
+		"images": map[string]interface{}{
+			"tags":        map[string]interface{}{"api": "api:1.0"},
+			"pull_policy": "Always",
+		},
+		// Not modelled by ArmadaChartValues
+		"ingress": map[string]interface{}{"public": false},
+		"custom":  map[string]interface{}{"api": map[string]interface{}{"node_selector_key": "openstack"}},
+		// Modelled, but with another shape
+		"replicas": map[string]interface{}{"api": "two"},
+		"network":  []interface{}{"a", "b"},
+		"etcd":     nil,
+	}
+
+	values, err := NewArmadaChartValues(raw)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(values.Images.PullPolicy).To(gomega.Equal("Always"))
+	g.Expect(values.Replicas).To(gomega.BeNil())
+	g.Expect(values.Unknown).To(gomega.HaveLen(5))
+
+	back, err := values.ToMap()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(back).To(gomega.Equal(raw))
+
+	// The copy keeps the unknown keys
+	back, err = values.DeepCopy().ToMap()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(back).To(gomega.Equal(raw))
+
+	// So does the merge
+	layer, err := NewChartValuesLayer("chart", values)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	set, err := NewSetValuesLayer("set", []string{"ingress.public=true"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	merged, err := MergeValuesLayers(layer, set).ChartValues()
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(merged.Unknown["ingress"].Raw).To(gomega.MatchJSON(`{"public":true}`))
+	g.Expect(merged.Unknown["custom"].Raw).To(gomega.MatchJSON(`{"api":{"node_selector_key":"openstack"}}`))
+}
@@ -5,7 +5,9 @@
 package v1alpha1
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -1427,6 +1429,109 @@ func (in *ArmadaBackupList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmadaBackupSchedule) DeepCopyInto(out *ArmadaBackupSchedule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaBackupSchedule.
+func (in *ArmadaBackupSchedule) DeepCopy() *ArmadaBackupSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmadaBackupSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArmadaBackupSchedule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmadaBackupScheduleList) DeepCopyInto(out *ArmadaBackupScheduleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ArmadaBackupSchedule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaBackupScheduleList.
+func (in *ArmadaBackupScheduleList) DeepCopy() *ArmadaBackupScheduleList {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmadaBackupScheduleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ArmadaBackupScheduleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmadaBackupScheduleSpec) DeepCopyInto(out *ArmadaBackupScheduleSpec) {
+	*out = *in
+	in.Template.DeepCopyInto(&out.Template)
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(BackupRetention)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaBackupScheduleSpec.
+func (in *ArmadaBackupScheduleSpec) DeepCopy() *ArmadaBackupScheduleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmadaBackupScheduleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArmadaBackupScheduleStatus) DeepCopyInto(out *ArmadaBackupScheduleStatus) {
+	*out = *in
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextScheduleTime != nil {
+		in, out := &in.NextScheduleTime, &out.NextScheduleTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaBackupScheduleStatus.
+func (in *ArmadaBackupScheduleStatus) DeepCopy() *ArmadaBackupScheduleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ArmadaBackupScheduleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ArmadaBackupSpec) DeepCopyInto(out *ArmadaBackupSpec) {
 	*out = *in
@@ -1589,6 +1694,11 @@ func (in *ArmadaChartGroupSpec) DeepCopy() *ArmadaChartGroupSpec {
 func (in *ArmadaChartGroupStatus) DeepCopyInto(out *ArmadaChartGroupStatus) {
 	*out = *in
 	in.ArmadaStatus.DeepCopyInto(&out.ArmadaStatus)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = new(ChildrenStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaChartGroupStatus.
@@ -1961,6 +2071,13 @@ func (in *ArmadaChartValues) DeepCopyInto(out *ArmadaChartValues) {
 		*out = new(AVVolume)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Unknown != nil {
+		in, out := &in.Unknown, &out.Unknown
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaChartValues.
@@ -2122,6 +2239,11 @@ func (in *ArmadaManifestSpec) DeepCopy() *ArmadaManifestSpec {
 func (in *ArmadaManifestStatus) DeepCopyInto(out *ArmadaManifestStatus) {
 	*out = *in
 	in.ArmadaStatus.DeepCopyInto(&out.ArmadaStatus)
+	if in.Children != nil {
+		in, out := &in.Children, &out.Children
+		*out = new(ChildrenStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaManifestStatus.
@@ -2233,6 +2355,11 @@ func (in *ArmadaRestoreSpec) DeepCopy() *ArmadaRestoreSpec {
 func (in *ArmadaRestoreStatus) DeepCopyInto(out *ArmadaRestoreStatus) {
 	*out = *in
 	in.ArmadaStatus.DeepCopyInto(&out.ArmadaStatus)
+	if in.Plan != nil {
+		in, out := &in.Plan, &out.Plan
+		*out = new(RestorePlan)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaRestoreStatus.
@@ -2492,6 +2619,11 @@ func (in *ArmadaWaitResourcesItems) DeepCopyInto(out *ArmadaWaitResourcesItems)
 			}
 		}
 	}
+	if in.MinReady != nil {
+		in, out := &in.MinReady, &out.MinReady
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArmadaWaitResourcesItems.
@@ -2519,6 +2651,26 @@ func (in *BackupPolicy) DeepCopy() *BackupPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupRetention) DeepCopyInto(out *BackupRetention) {
+	*out = *in
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupRetention.
+func (in *BackupRetention) DeepCopy() *BackupRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupSource) DeepCopyInto(out *BackupSource) {
 	*out = *in
@@ -2532,6 +2684,11 @@ func (in *BackupSource) DeepCopyInto(out *BackupSource) {
 		*out = new(CephBackupSource)
 		**out = **in
 	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalBackupSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSource.
@@ -2575,97 +2732,89 @@ func (in *CephRestoreSource) DeepCopy() *CephRestoreSource {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControllerRevision) DeepCopyInto(out *ControllerRevision) {
+func (in *ChildrenStatus) DeepCopyInto(out *ChildrenStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Data.DeepCopyInto(&out.Data)
+	if in.Failing != nil {
+		in, out := &in.Failing, &out.Failing
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRevision.
-func (in *ControllerRevision) DeepCopy() *ControllerRevision {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChildrenStatus.
+func (in *ChildrenStatus) DeepCopy() *ChildrenStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerRevision)
+	out := new(ChildrenStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControllerRevision) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmResourceCondition) DeepCopyInto(out *HelmResourceCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmResourceCondition.
+func (in *HelmResourceCondition) DeepCopy() *HelmResourceCondition {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(HelmResourceCondition)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ControllerRevisionList) DeepCopyInto(out *ControllerRevisionList) {
+func (in *HelmResourceConditionListHelper) DeepCopyInto(out *HelmResourceConditionListHelper) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ControllerRevision, len(*in))
+		*out = make([]HelmResourceCondition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControllerRevisionList.
-func (in *ControllerRevisionList) DeepCopy() *ControllerRevisionList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmResourceConditionListHelper.
+func (in *HelmResourceConditionListHelper) DeepCopy() *HelmResourceConditionListHelper {
 	if in == nil {
 		return nil
 	}
-	out := new(ControllerRevisionList)
+	out := new(HelmResourceConditionListHelper)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ControllerRevisionList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmResourceCondition) DeepCopyInto(out *HelmResourceCondition) {
+func (in *LocalBackupSource) DeepCopyInto(out *LocalBackupSource) {
 	*out = *in
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmResourceCondition.
-func (in *HelmResourceCondition) DeepCopy() *HelmResourceCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalBackupSource.
+func (in *LocalBackupSource) DeepCopy() *LocalBackupSource {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmResourceCondition)
+	out := new(LocalBackupSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HelmResourceConditionListHelper) DeepCopyInto(out *HelmResourceConditionListHelper) {
+func (in *LocalRestoreSource) DeepCopyInto(out *LocalRestoreSource) {
 	*out = *in
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]HelmResourceCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmResourceConditionListHelper.
-func (in *HelmResourceConditionListHelper) DeepCopy() *HelmResourceConditionListHelper {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRestoreSource.
+func (in *LocalRestoreSource) DeepCopy() *LocalRestoreSource {
 	if in == nil {
 		return nil
 	}
-	out := new(HelmResourceConditionListHelper)
+	out := new(LocalRestoreSource)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2700,6 +2849,55 @@ func (in *OffsiteRestoreSource) DeepCopy() *OffsiteRestoreSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestorePlan) DeepCopyInto(out *RestorePlan) {
+	*out = *in
+	if in.Created != nil {
+		in, out := &in.Created, &out.Created
+		*out = (*in).DeepCopy()
+	}
+	if in.Charts != nil {
+		in, out := &in.Charts, &out.Charts
+		*out = make([]RestorePlanItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Releases != nil {
+		in, out := &in.Releases, &out.Releases
+		*out = make([]RestorePlanItem, len(*in))
+		copy(*out, *in)
+	}
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePlan.
+func (in *RestorePlan) DeepCopy() *RestorePlan {
+	if in == nil {
+		return nil
+	}
+	out := new(RestorePlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestorePlanItem) DeepCopyInto(out *RestorePlanItem) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestorePlanItem.
+func (in *RestorePlanItem) DeepCopy() *RestorePlanItem {
+	if in == nil {
+		return nil
+	}
+	out := new(RestorePlanItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSource) DeepCopyInto(out *RestoreSource) {
 	*out = *in
@@ -2713,6 +2911,11 @@ func (in *RestoreSource) DeepCopyInto(out *RestoreSource) {
 		*out = new(CephRestoreSource)
 		**out = **in
 	}
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRestoreSource)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSource.
@@ -0,0 +1,124 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// ArmadaWaitNative configures the native “helm (install|upgrade) --wait“ flag
+type ArmadaWaitNative struct {
+	// Enabled defaults to true
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// ArmadaWaitResourcesItems is a kind of resource to wait on
+type ArmadaWaitResourcesItems struct {
+	// mapping of kubernetes resource labels
+	Labels *map[string]string `json:"labels,omitempty"`
+	// Only for controller ``type``s. Amount of pods in a controller which must be ready.
+	// Can be integer or percent string e.g. ``80%``. Default ``100%``.
+	MinReady *intstr.IntOrString `json:"minReady,omitempty"`
+	// k8s resource type, supports: controllers ('deployment', 'daemonset', 'statefulset', 'pod', 'job')
+	Type string `json:"type"`
+}
+
+// ArmadaWait configures the wait for the resources of a release
+type ArmadaWait struct {
+	// Base mapping of labels to wait on. They are added to any labels in
+	// each item in the ``resources`` array.
+	Labels *map[string]string `json:"labels,omitempty"`
+	// See ArmadaWaitNative
+	Native *ArmadaWaitNative `json:"native,omitempty"`
+	// Resources to wait on, with ``labels`` added to each item. Defaults
+	// to pods and jobs (if any exist) matching ``labels``.
+	Resources []*ArmadaWaitResourcesItems `json:"resources,omitempty"`
+	// Timeout for the chart to deploy
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ArmadaHookActionItems is a resource acted upon by an upgrade hook
+type ArmadaHookActionItems struct {
+	Labels *map[string]string `json:"labels,omitempty"`
+	Name   string             `json:"name,omitempty"`
+	Type   string             `json:"type"`
+}
+
+// ArmadaDelete configures the deletion of a release
+type ArmadaDelete struct {
+	// Timeout for the chart to be deleted
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// ArmadaUpgradeOptions are the options of helm upgrade
+type ArmadaUpgradeOptions struct {
+	Force        bool `json:"force,omitempty"`
+	RecreatePods bool `json:"recreatePods,omitempty"`
+}
+
+// ArmadaUpgradePre lists the actions performed prior to updating a release
+type ArmadaUpgradePre struct {
+	Create []*ArmadaHookActionItems `json:"create,omitempty"`
+	Delete []*ArmadaHookActionItems `json:"delete,omitempty"`
+	Update []*ArmadaHookActionItems `json:"update,omitempty"`
+}
+
+// ArmadaUpgradePost lists the actions performed after updating a release
+type ArmadaUpgradePost struct {
+	Create []*ArmadaHookActionItems `json:"create,omitempty"`
+}
+
+// ArmadaUpgrade configures the upgrade of a release
+type ArmadaUpgrade struct {
+	NoHooks bool                  `json:"noHooks"`
+	Options *ArmadaUpgradeOptions `json:"options,omitempty"`
+	Post    *ArmadaUpgradePost    `json:"post,omitempty"`
+	Pre     *ArmadaUpgradePre     `json:"pre,omitempty"`
+}
+
+// ArmadaProtectedRelease protects a release from being purged
+type ArmadaProtectedRelease struct {
+	// do not delete FAILED releases when encountered from previous run:
+	// continue or halt execution (default: halt)
+	ContinueProcessing bool `json:"continueProcessing,omitempty"`
+}
+
+// ArmadaChartSource locates the chart
+type ArmadaChartSource struct {
+	AuthMethod string `json:"authMethod,omitempty"`
+	// ``url`` or ``path`` to the chart's parent directory
+	Location    string `json:"location"`
+	ProxyServer string `json:"proxyServer,omitempty"`
+	// (optional) branch, commit, or reference in the repo (``master`` if not specified)
+	Reference string `json:"reference,omitempty"`
+	// (optional) relative path to target chart from parent (``.`` if not specified)
+	Subpath string `json:"subpath"`
+	// source to build the chart: ``git``, ``local``, or ``tar``
+	Type string `json:"type"`
+}
+
+// ArmadaTestOptions are the options of helm test
+type ArmadaTestOptions struct {
+	Cleanup bool `json:"cleanup,omitempty"`
+}
+
+// ArmadaTest configures the helm tests of a release
+type ArmadaTest struct {
+	Enabled bool `json:"enabled,omitempty"`
+	// Timeout for the tests to run
+	Timeout *metav1.Duration   `json:"timeout,omitempty"`
+	Options *ArmadaTestOptions `json:"options,omitempty"`
+}
@@ -0,0 +1,97 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ArmadaChartSpec defines the desired state of ArmadaChart
+type ArmadaChartSpec struct {
+	// name for the chart
+	ChartName string `json:"chartName"`
+	// namespace of your chart
+	Namespace string `json:"namespace,omitempty"`
+	// name of the release (Armada will prepend with the release prefix during processing)
+	Release string `json:"release"`
+	// provide a path to a ``git repo``, ``local dir``, or ``tarball url`` chart
+	Source *ArmadaChartSource `json:"source"`
+	// charts to install before this one
+	Dependencies []ChartReference `json:"dependencies,omitempty"`
+
+	// override any default values in the charts
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Values *runtime.RawExtension `json:"values,omitempty"`
+	// See ArmadaDelete
+	Delete *ArmadaDelete `json:"delete,omitempty"`
+	// upgrade the chart managed by the armada yaml
+	Upgrade *ArmadaUpgrade `json:"upgrade,omitempty"`
+
+	// do not delete FAILED releases when encountered from previous run
+	Protected *ArmadaProtectedRelease `json:"protected,omitempty"`
+	// See ArmadaTest
+	Test *ArmadaTest `json:"test,omitempty"`
+	// See ArmadaWait
+	Wait *ArmadaWait `json:"wait,omitempty"`
+
+	// Target state of the Helm Custom Resources
+	TargetState HelmResourceState `json:"targetState"`
+
+	// Library marks a chart, such as helm-toolkit, which only provides
+	// templates to the other charts and is never deployed on its own.
+	Library bool `json:"library,omitempty"`
+
+	// revisionHistoryLimit is the maximum number of revisions that will
+	// be maintained in the ArmadaChart's revision history. The default value is 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// ArmadaChartStatus defines the observed state of ArmadaChart
+type ArmadaChartStatus struct {
+	ArmadaStatus `json:",inline"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaChart is the Schema for the armadacharts API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:path=armadacharts,shortName=act
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actualState",description="State"
+// +kubebuilder:printcolumn:name="Target State",type="string",JSONPath=".spec.targetState",description="Target State"
+// +kubebuilder:printcolumn:name="Satisfied",type="boolean",JSONPath=".status.satisfied",description="Satisfied"
+type ArmadaChart struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArmadaChartSpec   `json:"spec,omitempty"`
+	Status ArmadaChartStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaChartList contains a list of ArmadaChart
+type ArmadaChartList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArmadaChart `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArmadaChart{}, &ArmadaChartList{})
+}
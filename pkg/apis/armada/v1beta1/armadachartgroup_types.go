@@ -0,0 +1,80 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArmadaChartGroupSpec defines the desired state of ArmadaChartGroup
+type ArmadaChartGroupSpec struct {
+	// charts of the group
+	Charts []ChartReference `json:"charts"`
+	// description of chart set
+	Description string `json:"description,omitempty"`
+	// Name of the chartgroup
+	Name string `json:"name,omitempty"`
+	// enables sequenced chart deployment in a group
+	Sequenced bool `json:"sequenced,omitempty"`
+
+	// Target state of the Helm Custom Resources
+	TargetState HelmResourceState `json:"targetState"`
+	// revisionHistoryLimit is the maximum number of revisions that will
+	// be maintained in the ArmadaChartGroup's revision history. The default value is 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// ArmadaChartGroupStatus defines the observed state of ArmadaChartGroup
+type ArmadaChartGroupStatus struct {
+	ArmadaStatus `json:",inline"`
+
+	// Children summarizes the states of the charts
+	Children *ChildrenStatus `json:"children,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaChartGroup is the Schema for the armadachartgroups API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:path=armadachartgroups,shortName=acg
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actualState",description="State"
+// +kubebuilder:printcolumn:name="Target State",type="string",JSONPath=".spec.targetState",description="Target State"
+// +kubebuilder:printcolumn:name="Satisfied",type="boolean",JSONPath=".status.satisfied",description="Satisfied"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.children.progress",description="Percentage of ready charts"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.children.failed",description="Number of failed charts"
+// +kubebuilder:printcolumn:name="Blocking",type="string",JSONPath=".status.children.blocking",description="First chart not ready"
+type ArmadaChartGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArmadaChartGroupSpec   `json:"spec,omitempty"`
+	Status ArmadaChartGroupStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaChartGroupList contains a list of ArmadaChartGroup
+type ArmadaChartGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArmadaChartGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArmadaChartGroup{}, &ArmadaChartGroupList{})
+}
@@ -0,0 +1,76 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ArmadaManifestSpec defines the desired state of ArmadaManifest
+type ArmadaManifestSpec struct {
+	// chart groups deployed by the manifest
+	ChartGroups []ChartGroupReference `json:"chartGroups"`
+	// prefix appended to the release names of the charts
+	ReleasePrefix string `json:"releasePrefix"`
+
+	// Target state of the Helm Custom Resources
+	TargetState HelmResourceState `json:"targetState"`
+	// revisionHistoryLimit is the maximum number of revisions that will
+	// be maintained in the ArmadaManifest's revision history. The default value is 10.
+	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
+}
+
+// ArmadaManifestStatus defines the observed state of ArmadaManifest
+type ArmadaManifestStatus struct {
+	ArmadaStatus `json:",inline"`
+
+	// Children summarizes the states of the chart groups
+	Children *ChildrenStatus `json:"children,omitempty"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaManifest is the Schema for the armadamanifests API
+// +k8s:openapi-gen=true
+// +kubebuilder:subresource:status
+// +kubebuilder:unservedversion
+// +kubebuilder:resource:path=armadamanifests,shortName=amf
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.actualState",description="State"
+// +kubebuilder:printcolumn:name="Target State",type="string",JSONPath=".spec.targetState",description="Target State"
+// +kubebuilder:printcolumn:name="Satisfied",type="boolean",JSONPath=".status.satisfied",description="Satisfied"
+// +kubebuilder:printcolumn:name="Progress",type="integer",JSONPath=".status.children.progress",description="Percentage of ready chart groups"
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.children.failed",description="Number of failed chart groups"
+// +kubebuilder:printcolumn:name="Blocking",type="string",JSONPath=".status.children.blocking",description="First chart group not ready"
+type ArmadaManifest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ArmadaManifestSpec   `json:"spec,omitempty"`
+	Status ArmadaManifestStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ArmadaManifestList contains a list of ArmadaManifest
+type ArmadaManifestList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ArmadaManifest `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ArmadaManifest{}, &ArmadaManifestList{})
+}
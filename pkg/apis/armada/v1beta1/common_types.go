@@ -0,0 +1,108 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelmResourceState is the status of a release/chart/chartgroup/manifest
+type HelmResourceState string
+
+// HelmResourceConditionType is the type of a HelmResourceCondition
+type HelmResourceConditionType string
+
+// HelmResourceConditionStatus represents the current status of a Condition
+type HelmResourceConditionStatus string
+
+// HelmResourceConditionReason is the reason of a HelmResourceCondition
+type HelmResourceConditionReason string
+
+// Describe the status of a release. The values are the ones of v1alpha1.
+const (
+	StateUninitialized HelmResourceState = "uninitialized"
+	StateUnknown       HelmResourceState = "unknown"
+	StateInitialized   HelmResourceState = "initialized"
+	StateDeployed      HelmResourceState = "deployed"
+	StateUninstalled   HelmResourceState = "uninstalled"
+	StateFailed        HelmResourceState = "failed"
+	StatePending       HelmResourceState = "pending"
+	StateRunning       HelmResourceState = "running"
+	StateError         HelmResourceState = "error"
+)
+
+// HelmResourceCondition represents one current condition of an Helm resource.
+// It is a metav1.Condition on the wire, plus the name and version of the
+// resource it applies to.
+type HelmResourceCondition struct {
+	Type               HelmResourceConditionType   `json:"type"`
+	Status             HelmResourceConditionStatus `json:"status"`
+	Reason             HelmResourceConditionReason `json:"reason,omitempty"`
+	Message            string                      `json:"message,omitempty"`
+	ResourceName       string                      `json:"resourceName,omitempty"`
+	ResourceVersion    int32                       `json:"resourceVersion,omitempty"`
+	LastTransitionTime metav1.Time                 `json:"lastTransitionTime,omitempty"`
+	ObservedGeneration int64                       `json:"observedGeneration,omitempty"`
+}
+
+// ArmadaStatus represents the common attributes shared amongst armada resources
+type ArmadaStatus struct {
+	// Satisfied indicates if the release's ActualState satisfies its target state
+	Satisfied bool `json:"satisfied"`
+	// Reason indicates the reason for any related failures.
+	Reason string `json:"reason,omitempty"`
+	// Actual state of the Helm Custom Resources
+	ActualState HelmResourceState `json:"actualState"`
+	// List of conditions and states related to the resource, the Ready,
+	// Reconciling and Stalled ones included.
+	Conditions []HelmResourceCondition `json:"conditions,omitempty"`
+	// ObservedGeneration is the generation of the spec the status reflects
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// ChildrenStatus summarizes the states of the charts of an ArmadaChartGroup
+// or of the chart groups of an ArmadaManifest. Library charts are not
+// counted.
+type ChildrenStatus struct {
+	// Total number of children
+	Total int32 `json:"total"`
+	// Ready children are deployed
+	Ready int32 `json:"ready"`
+	// Pending children are enabled but neither ready nor failed yet
+	Pending int32 `json:"pending"`
+	// Failed children are in the failed or error state
+	Failed int32 `json:"failed"`
+	// Disabled children have not been enabled yet
+	Disabled int32 `json:"disabled"`
+	// Failing lists the names of the failed children
+	Failing []string `json:"failing,omitempty"`
+	// Progress is the percentage of ready children
+	Progress int32 `json:"progress"`
+	// Blocking is the first child, in the order listed by the spec, which
+	// is not ready.
+	Blocking string `json:"blocking,omitempty"`
+}
+
+// ChartReference references an ArmadaChart of the same namespace
+type ChartReference struct {
+	// Name of the ArmadaChart
+	Name string `json:"name"`
+}
+
+// ChartGroupReference references an ArmadaChartGroup of the same namespace
+type ChartGroupReference struct {
+	// Name of the ArmadaChartGroup
+	Name string `json:"name"`
+}
@@ -0,0 +1,301 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// The fields removed from v1beta1 are kept in annotations while an object
+// is served as v1beta1, and the v1beta1 durations v1alpha1 truncates while
+// it is served as v1alpha1, so that writing it back does not lose them.
+const (
+	// TimeoutAnnotation holds the deprecated ArmadaChartSpec.Timeout
+	TimeoutAnnotation = "armada.airshipit.org/v1alpha1-timeout"
+	// TestChartsAnnotation holds the deprecated ArmadaChartGroupSpec.TestCharts
+	TestChartsAnnotation = "armada.airshipit.org/v1alpha1-test-charts"
+	// DurationsAnnotation holds the ArmadaChartSpec durations with fractions
+	// of a second, by field path, such as {"wait.timeout": "1.5s"}
+	DurationsAnnotation = "armada.airshipit.org/v1beta1-durations"
+)
+
+var _ ctrlconversion.Convertible = &ArmadaChart{}
+var _ ctrlconversion.Convertible = &ArmadaChartGroup{}
+var _ ctrlconversion.Convertible = &ArmadaManifest{}
+
+// ConvertTo converts the ArmadaChart to the v1alpha1 hub
+func (src *ArmadaChart) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*av1.ArmadaChart)
+	if err := Convert_v1beta1_ArmadaChart_To_v1alpha1_ArmadaChart(src, dst, nil); err != nil {
+		return err
+	}
+	if value, ok := popAnnotation(&dst.ObjectMeta, TimeoutAnnotation); ok {
+		timeout, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		dst.Spec.Timeout = timeout
+	}
+	exact := make(map[string]metav1.Duration)
+	for path, d := range chartDurations(&src.Spec) {
+		if *d != nil && (*d).Duration%time.Second != 0 {
+			exact[path] = **d
+		}
+	}
+	if len(exact) > 0 {
+		value, err := json.Marshal(exact)
+		if err != nil {
+			return err
+		}
+		setAnnotation(&dst.ObjectMeta, DurationsAnnotation, string(value))
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub to an ArmadaChart
+func (dst *ArmadaChart) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*av1.ArmadaChart)
+	if err := Convert_v1alpha1_ArmadaChart_To_v1beta1_ArmadaChart(src, dst, nil); err != nil {
+		return err
+	}
+	if src.Spec.Timeout != 0 {
+		setAnnotation(&dst.ObjectMeta, TimeoutAnnotation, strconv.Itoa(src.Spec.Timeout))
+	}
+	if value, ok := popAnnotation(&dst.ObjectMeta, DurationsAnnotation); ok {
+		exact := make(map[string]metav1.Duration)
+		if err := json.Unmarshal([]byte(value), &exact); err != nil {
+			return err
+		}
+		durations := chartDurations(&dst.Spec)
+		for path, duration := range exact {
+			d, ok := durations[path]
+			if !ok {
+				continue
+			}
+			var seconds time.Duration
+			if *d != nil {
+				seconds = (*d).Duration
+			}
+			// Unless the duration was changed since, as v1alpha1
+			if seconds == duration.Truncate(time.Second) {
+				*d = &duration
+			}
+		}
+	}
+	return nil
+}
+
+// chartDurations returns the durations of an ArmadaChartSpec, by field path
+func chartDurations(spec *ArmadaChartSpec) map[string]**metav1.Duration {
+	res := make(map[string]**metav1.Duration)
+	if spec.Wait != nil {
+		res["wait.timeout"] = &spec.Wait.Timeout
+	}
+	if spec.Delete != nil {
+		res["delete.timeout"] = &spec.Delete.Timeout
+	}
+	if spec.Test != nil {
+		res["test.timeout"] = &spec.Test.Timeout
+	}
+	return res
+}
+
+// ConvertTo converts the ArmadaChartGroup to the v1alpha1 hub
+func (src *ArmadaChartGroup) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	dst := dstRaw.(*av1.ArmadaChartGroup)
+	if err := Convert_v1beta1_ArmadaChartGroup_To_v1alpha1_ArmadaChartGroup(src, dst, nil); err != nil {
+		return err
+	}
+	if value, ok := popAnnotation(&dst.ObjectMeta, TestChartsAnnotation); ok {
+		testCharts, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		dst.Spec.TestCharts = testCharts
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 hub to an ArmadaChartGroup
+func (dst *ArmadaChartGroup) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	src := srcRaw.(*av1.ArmadaChartGroup)
+	if err := Convert_v1alpha1_ArmadaChartGroup_To_v1beta1_ArmadaChartGroup(src, dst, nil); err != nil {
+		return err
+	}
+	if src.Spec.TestCharts {
+		setAnnotation(&dst.ObjectMeta, TestChartsAnnotation, "true")
+	}
+	return nil
+}
+
+// ConvertTo converts the ArmadaManifest to the v1alpha1 hub
+func (src *ArmadaManifest) ConvertTo(dstRaw ctrlconversion.Hub) error {
+	return Convert_v1beta1_ArmadaManifest_To_v1alpha1_ArmadaManifest(src, dstRaw.(*av1.ArmadaManifest), nil)
+}
+
+// ConvertFrom converts the v1alpha1 hub to an ArmadaManifest
+func (dst *ArmadaManifest) ConvertFrom(srcRaw ctrlconversion.Hub) error {
+	return Convert_v1alpha1_ArmadaManifest_To_v1beta1_ArmadaManifest(srcRaw.(*av1.ArmadaManifest), dst, nil)
+}
+
+// setAnnotation sets an annotation on a copy of the annotations, which the
+// generated conversions share between the source and the destination.
+func setAnnotation(meta *metav1.ObjectMeta, key string, value string) {
+	annotations := make(map[string]string, len(meta.Annotations)+1)
+	for k, v := range meta.Annotations {
+		annotations[k] = v
+	}
+	annotations[key] = value
+	meta.Annotations = annotations
+}
+
+// popAnnotation removes an annotation, from a copy of the annotations,
+// and returns its value.
+func popAnnotation(meta *metav1.ObjectMeta, key string) (string, bool) {
+	value, ok := meta.Annotations[key]
+	if !ok {
+		return "", false
+	}
+	var annotations map[string]string
+	if len(meta.Annotations) > 1 {
+		annotations = make(map[string]string, len(meta.Annotations)-1)
+		for k, v := range meta.Annotations {
+			if k != key {
+				annotations[k] = v
+			}
+		}
+	}
+	meta.Annotations = annotations
+	return value, true
+}
+
+// Convert_v1alpha1_ArmadaChartSpec_To_v1beta1_ArmadaChartSpec drops the
+// deprecated Timeout.
+func Convert_v1alpha1_ArmadaChartSpec_To_v1beta1_ArmadaChartSpec(in *av1.ArmadaChartSpec, out *ArmadaChartSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArmadaChartSpec_To_v1beta1_ArmadaChartSpec(in, out, s)
+}
+
+// Convert_v1alpha1_ArmadaChartValues_To_runtime_RawExtension serializes the
+// values.
+func Convert_v1alpha1_ArmadaChartValues_To_runtime_RawExtension(in *av1.ArmadaChartValues, out *runtime.RawExtension, s conversion.Scope) error {
+	raw, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	*out = runtime.RawExtension{Raw: raw}
+	return nil
+}
+
+// Convert_runtime_RawExtension_To_v1alpha1_ArmadaChartValues decodes the
+// values; the keys v1alpha1 does not model are preserved.
+func Convert_runtime_RawExtension_To_v1alpha1_ArmadaChartValues(in *runtime.RawExtension, out *av1.ArmadaChartValues, s conversion.Scope) error {
+	raw := in.Raw
+	if raw == nil && in.Object != nil {
+		var err error
+		if raw, err = json.Marshal(in.Object); err != nil {
+			return err
+		}
+	}
+	*out = av1.ArmadaChartValues{}
+	if len(raw) == 0 {
+		return nil
+	}
+	return json.Unmarshal(raw, out)
+}
+
+// Convert_v1alpha1_ArmadaChartGroupSpec_To_v1beta1_ArmadaChartGroupSpec drops
+// the deprecated TestCharts.
+func Convert_v1alpha1_ArmadaChartGroupSpec_To_v1beta1_ArmadaChartGroupSpec(in *av1.ArmadaChartGroupSpec, out *ArmadaChartGroupSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArmadaChartGroupSpec_To_v1beta1_ArmadaChartGroupSpec(in, out, s)
+}
+
+// Convert_int64_To_Pointer_v1_Duration converts seconds into a duration,
+// nil for 0.
+func Convert_int64_To_Pointer_v1_Duration(in *int64, out **metav1.Duration, s conversion.Scope) error {
+	*out = nil
+	if *in != 0 {
+		*out = &metav1.Duration{Duration: time.Duration(*in) * time.Second}
+	}
+	return nil
+}
+
+// Convert_Pointer_v1_Duration_To_int64 converts a duration into seconds.
+// The fractions of a second are truncated; ArmadaChart.ConvertTo keeps the
+// exact durations in the DurationsAnnotation.
+func Convert_Pointer_v1_Duration_To_int64(in **metav1.Duration, out *int64, s conversion.Scope) error {
+	*out = 0
+	if *in != nil {
+		*out = int64((*in).Duration / time.Second)
+	}
+	return nil
+}
+
+// Convert_Slice_string_To_Slice_v1beta1_ChartReference converts chart names.
+// An empty list stays an empty list.
+func Convert_Slice_string_To_Slice_v1beta1_ChartReference(in *[]string, out *[]ChartReference, s conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = make([]ChartReference, 0, len(*in))
+	for _, name := range *in {
+		*out = append(*out, ChartReference{Name: name})
+	}
+	return nil
+}
+
+// Convert_Slice_v1beta1_ChartReference_To_Slice_string converts chart
+// references. The list is never nil: dependencies and chart_group are
+// required in v1alpha1 and must not be serialized as null.
+func Convert_Slice_v1beta1_ChartReference_To_Slice_string(in *[]ChartReference, out *[]string, s conversion.Scope) error {
+	*out = make([]string, 0, len(*in))
+	for _, ref := range *in {
+		*out = append(*out, ref.Name)
+	}
+	return nil
+}
+
+// Convert_Slice_string_To_Slice_v1beta1_ChartGroupReference converts chart
+// group names. An empty list stays an empty list.
+func Convert_Slice_string_To_Slice_v1beta1_ChartGroupReference(in *[]string, out *[]ChartGroupReference, s conversion.Scope) error {
+	if *in == nil {
+		*out = nil
+		return nil
+	}
+	*out = make([]ChartGroupReference, 0, len(*in))
+	for _, name := range *in {
+		*out = append(*out, ChartGroupReference{Name: name})
+	}
+	return nil
+}
+
+// Convert_Slice_v1beta1_ChartGroupReference_To_Slice_string converts chart
+// group references. The list is never nil: chart_groups is required in
+// v1alpha1 and must not be serialized as null.
+func Convert_Slice_v1beta1_ChartGroupReference_To_Slice_string(in *[]ChartGroupReference, out *[]string, s conversion.Scope) error {
+	*out = make([]string, 0, len(*in))
+	for _, ref := range *in {
+		*out = append(*out, ref.Name)
+	}
+	return nil
+}
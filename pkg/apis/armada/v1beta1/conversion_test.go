@@ -0,0 +1,258 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/conversion"
+	"sigs.k8s.io/randfill"
+)
+
+// fuzzIterations is the number of random objects converted per kind and direction
+const fuzzIterations = 200
+
+// armadaFuzzerFuncs restricts the random objects to the ones which have a
+// representation in both versions: durations which fit in seconds, with
+// fractions of a second for half of them, JSON objects for the values.
+func armadaFuzzerFuncs(_ runtimeserializer.CodecFactory) []interface{} {
+	return []interface{}{
+		func(d **metav1.Duration, c randfill.Continue) {
+			*d = nil
+			if seconds := c.Int31(); seconds != 0 && c.Bool() {
+				*d = &metav1.Duration{Duration: time.Duration(seconds) * time.Second}
+				if c.Bool() {
+					(*d).Duration += time.Duration(c.Int63n(int64(time.Second)))
+				}
+			}
+		},
+		func(w *av1.ArmadaWait, c randfill.Continue) {
+			c.FillNoCustom(w)
+			w.Timeout = int64(c.Int31())
+		},
+		func(d *av1.ArmadaDelete, c randfill.Continue) {
+			c.FillNoCustom(d)
+			d.Timeout = int64(c.Int31())
+		},
+		func(t *av1.ArmadaTest, c randfill.Continue) {
+			c.FillNoCustom(t)
+			t.Timeout = int64(c.Int31())
+		},
+		func(v *av1.ArmadaChartValues, c randfill.Continue) {
+			*v = av1.ArmadaChartValues{}
+			if err := json.Unmarshal(randomValues(c), v); err != nil {
+				panic(err)
+			}
+		},
+		func(r *runtime.RawExtension, c randfill.Continue) {
+			*r = runtime.RawExtension{Raw: randomValues(c)}
+		},
+	}
+}
+
+// randomValues returns chart values with a modelled and an unknown key
+func randomValues(c randfill.Continue) []byte {
+	values := map[string]interface{}{
+		"replicas": map[string]int{c.String(0): int(c.Int31())},
+		"custom":   map[string]interface{}{"enabled": c.Bool(), "name": c.String(0)},
+	}
+	raw, err := json.Marshal(values)
+	if err != nil {
+		panic(err)
+	}
+	return raw
+}
+
+// semantic compares the values by their JSON content, the order of the
+// keys aside.
+var semantic = conversion.EqualitiesOrDie(
+	func(a, b metav1.Time) bool {
+		return a.Equal(&b)
+	},
+	func(a, b runtime.RawExtension) bool {
+		var x, y interface{}
+		if json.Unmarshal(a.Raw, &x) != nil || json.Unmarshal(b.Raw, &y) != nil {
+			return string(a.Raw) == string(b.Raw)
+		}
+		return reflect.DeepEqual(x, y)
+	},
+)
+
+func newFuzzer(t *testing.T) *randfill.Filler {
+	seed := time.Now().UnixNano()
+	t.Logf("fuzzer seed %d", seed)
+	scheme := runtime.NewScheme()
+	codecs := runtimeserializer.NewCodecFactory(scheme)
+	return fuzzer.FuzzerFor(fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, armadaFuzzerFuncs), rand.NewSource(seed), codecs)
+}
+
+// fuzzRoundTrip converts random objects from the hub to the spoke and back,
+// and from the spoke to the hub and back.
+func fuzzRoundTrip(t *testing.T, newHub func() ctrlconversion.Hub, newSpoke func() ctrlconversion.Convertible) {
+	g := gomega.NewGomegaWithT(t)
+	f := newFuzzer(t)
+
+	for i := 0; i < fuzzIterations; i++ {
+		hub := newHub()
+		f.Fill(hub)
+		spoke := newSpoke()
+		g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+		back := newHub()
+		g.Expect(spoke.ConvertTo(back)).To(gomega.Succeed())
+		if !semantic.DeepEqual(hub, back) {
+			t.Fatalf("hub -> spoke -> hub:\n%s", diff.Diff(hub, back))
+		}
+	}
+
+	for i := 0; i < fuzzIterations; i++ {
+		spoke := newSpoke()
+		f.Fill(spoke)
+		hub := newHub()
+		g.Expect(spoke.ConvertTo(hub)).To(gomega.Succeed())
+		back := newSpoke()
+		g.Expect(back.ConvertFrom(hub)).To(gomega.Succeed())
+		if !semantic.DeepEqual(spoke, back) {
+			t.Fatalf("spoke -> hub -> spoke:\n%s", diff.Diff(spoke, back))
+		}
+	}
+}
+
+func TestArmadaChartFuzzyConversion(t *testing.T) {
+	fuzzRoundTrip(t,
+		func() ctrlconversion.Hub { return &av1.ArmadaChart{} },
+		func() ctrlconversion.Convertible { return &ArmadaChart{} })
+}
+
+func TestArmadaChartGroupFuzzyConversion(t *testing.T) {
+	fuzzRoundTrip(t,
+		func() ctrlconversion.Hub { return &av1.ArmadaChartGroup{} },
+		func() ctrlconversion.Convertible { return &ArmadaChartGroup{} })
+}
+
+func TestArmadaManifestFuzzyConversion(t *testing.T) {
+	fuzzRoundTrip(t,
+		func() ctrlconversion.Hub { return &av1.ArmadaManifest{} },
+		func() ctrlconversion.Convertible { return &ArmadaManifest{} })
+}
+
+func TestArmadaChartConversion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	hub := &av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: "keystone", Annotations: map[string]string{"owner": "ops"}},
+		Spec: av1.ArmadaChartSpec{
+			ChartName:    "keystone",
+			Release:      "keystone",
+			Dependencies: []string{"helm-toolkit"},
+			Timeout:      600,
+			Wait:         &av1.ArmadaWait{Timeout: 90},
+			TargetState:  av1.StateDeployed,
+			Values:       &av1.ArmadaChartValues{Replicas: &map[string]int{"api": 2}},
+		},
+	}
+	spoke := &ArmadaChart{}
+	g.Expect(spoke.ConvertFrom(hub)).To(gomega.Succeed())
+	g.Expect(spoke.Spec.Dependencies).To(gomega.Equal([]ChartReference{{Name: "helm-toolkit"}}))
+	g.Expect(spoke.Spec.Wait.Timeout.Duration).To(gomega.Equal(90 * time.Second))
+	g.Expect(spoke.Spec.TargetState).To(gomega.Equal(StateDeployed))
+	g.Expect(string(spoke.Spec.Values.Raw)).To(gomega.MatchJSON(`{"replicas": {"api": 2}}`))
+	g.Expect(spoke.Annotations).To(gomega.HaveKeyWithValue(TimeoutAnnotation, "600"))
+	// The annotations of the hub are left alone
+	g.Expect(hub.Annotations).NotTo(gomega.HaveKey(TimeoutAnnotation))
+
+	blob, err := json.Marshal(spoke.Spec)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"chartName":"keystone"`))
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"targetState":"deployed"`))
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"timeout":"1m30s"`))
+
+	// The fractions of a second are truncated, and kept in an annotation
+	spoke.Spec.Wait.Timeout = &metav1.Duration{Duration: 1500 * time.Millisecond}
+	spoke.Spec.Test = &ArmadaTest{Timeout: &metav1.Duration{Duration: 500 * time.Millisecond}}
+	back := &av1.ArmadaChart{}
+	g.Expect(spoke.ConvertTo(back)).To(gomega.Succeed())
+	g.Expect(back.Spec.Wait.Timeout).To(gomega.Equal(int64(1)))
+	g.Expect(back.Spec.Test.Timeout).To(gomega.Equal(int64(0)))
+	g.Expect(back.Spec.Timeout).To(gomega.Equal(600))
+	g.Expect(back.Annotations).To(gomega.HaveKeyWithValue("owner", "ops"))
+	g.Expect(back.Annotations[DurationsAnnotation]).To(gomega.MatchJSON(`{"wait.timeout": "1.5s", "test.timeout": "500ms"}`))
+
+	// which restores them
+	roundTrip := &ArmadaChart{}
+	g.Expect(roundTrip.ConvertFrom(back)).To(gomega.Succeed())
+	g.Expect(roundTrip.Spec.Wait.Timeout.Duration).To(gomega.Equal(1500 * time.Millisecond))
+	g.Expect(roundTrip.Spec.Test.Timeout.Duration).To(gomega.Equal(500 * time.Millisecond))
+	g.Expect(roundTrip.Annotations).NotTo(gomega.HaveKey(DurationsAnnotation))
+
+	// unless the duration was changed as v1alpha1
+	back.Spec.Wait.Timeout = 2
+	roundTrip = &ArmadaChart{}
+	g.Expect(roundTrip.ConvertFrom(back)).To(gomega.Succeed())
+	g.Expect(roundTrip.Spec.Wait.Timeout.Duration).To(gomega.Equal(2 * time.Second))
+	g.Expect(roundTrip.Spec.Test.Timeout.Duration).To(gomega.Equal(500 * time.Millisecond))
+}
+
+func TestEmptyReferenceListConversion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// v1beta1 to v1alpha1: the required lists are never serialized as null,
+	// whether the v1beta1 list is empty or omitted
+	chart := &av1.ArmadaChart{}
+	g.Expect((&ArmadaChart{Spec: ArmadaChartSpec{Dependencies: []ChartReference{}}}).ConvertTo(chart)).To(gomega.Succeed())
+	blob, err := json.Marshal(chart.Spec)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"dependencies":[]`))
+
+	chart = &av1.ArmadaChart{}
+	g.Expect((&ArmadaChart{}).ConvertTo(chart)).To(gomega.Succeed())
+	blob, err = json.Marshal(chart.Spec)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"dependencies":[]`))
+
+	group := &av1.ArmadaChartGroup{}
+	g.Expect((&ArmadaChartGroup{Spec: ArmadaChartGroupSpec{Charts: []ChartReference{}}}).ConvertTo(group)).To(gomega.Succeed())
+	blob, err = json.Marshal(group.Spec)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"chart_group":[]`))
+
+	manifest := &av1.ArmadaManifest{}
+	g.Expect((&ArmadaManifest{Spec: ArmadaManifestSpec{ChartGroups: []ChartGroupReference{}}}).ConvertTo(manifest)).To(gomega.Succeed())
+	blob, err = json.Marshal(manifest.Spec)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(string(blob)).To(gomega.ContainSubstring(`"chart_groups":[]`))
+
+	// v1alpha1 to v1beta1: an empty list stays empty and a nil list nil
+	spokeGroup := &ArmadaChartGroup{}
+	g.Expect(spokeGroup.ConvertFrom(&av1.ArmadaChartGroup{Spec: av1.ArmadaChartGroupSpec{Charts: []string{}}})).To(gomega.Succeed())
+	g.Expect(spokeGroup.Spec.Charts).NotTo(gomega.BeNil())
+	g.Expect(spokeGroup.Spec.Charts).To(gomega.BeEmpty())
+
+	spokeManifest := &ArmadaManifest{}
+	g.Expect(spokeManifest.ConvertFrom(&av1.ArmadaManifest{})).To(gomega.Succeed())
+	g.Expect(spokeManifest.Spec.ChartGroups).To(gomega.BeNil())
+}
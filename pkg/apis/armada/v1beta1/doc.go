@@ -0,0 +1,26 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package v1beta1 contains API Schema definitions for the armada v1beta1 API group.
+// Compared to v1alpha1 the JSON names are camelCase, the timeouts are
+// metav1.Durations, the charts and chart groups are referenced by typed
+// references and the deprecated fields are gone. v1alpha1 remains the
+// storage version and the hub of the conversions. v1beta1 is not served
+// until the conversion webhook of pkg/webhook is deployed with the CRDs.
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen=package,register
+// +k8s:conversion-gen=github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1
+// +groupName=armada.airshipit.org
+
+package v1beta1
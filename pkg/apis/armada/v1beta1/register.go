@@ -0,0 +1,51 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// NOTE: Boilerplate only.  Ignore this file.
+
+// Package v1beta1 contains API Schema definitions for the armada v1beta1 API group
+// +k8s:deepcopy-gen=package,register
+// +groupName=armada.airshipit.org
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen=package,register
+// +groupName=armada.airshipit.org
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: "armada.airshipit.org", Version: "v1beta1"}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	// Deferred fleet-wide migration to runtime.NewSchemeBuilder; tracked as an
+	// owed follow-up alongside the same change in kubedge-operator-base.
+	//nolint:staticcheck // SA1019: scheme.Builder deprecation, migration pending
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+
+	// AddToScheme is required by the generated clientsets in pkg/client
+	AddToScheme = SchemeBuilder.AddToScheme
+
+	// localSchemeBuilder is used by the generated conversion functions
+	localSchemeBuilder = &SchemeBuilder.SchemeBuilder
+)
+
+// Resource takes an unqualified resource and returns a Group qualified GroupResource.
+// It is required by the generated listers in pkg/client.
+func Resource(resource string) schema.GroupResource {
+	return SchemeGroupVersion.WithResource(resource).GroupResource()
+}
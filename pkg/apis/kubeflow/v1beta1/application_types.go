@@ -24,6 +24,7 @@ const (
 	KfConfigFile = "app.yaml"
 )
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // KfDef is the Schema for the applications API
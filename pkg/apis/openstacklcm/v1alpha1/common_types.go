@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	"reflect"
 
+	"github.com/keleustes/armada-crd/pkg/lifecycle"
 	yaml "gopkg.in/yaml.v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -114,6 +115,10 @@ type LcmResourceCondition struct {
 	ResourceName       string                     `json:"resourceName,omitempty"`
 	ResourceVersion    int32                      `json:"resourceVersion,omitempty"`
 	LastTransitionTime metav1.Time                `json:"lastTransitionTime,omitempty"`
+	// ObservedGeneration is the generation of the spec the condition
+	// was computed from. The field makes the condition a metav1.Condition
+	// on the wire.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 type LcmResourceConditionListHelper struct {
@@ -129,7 +134,11 @@ type OpenstackLcmStatus struct {
 	// Actual state of the Lcm Custom Resources
 	ActualState LcmResourceState `json:"actualState"`
 	// List of conditions and states related to the resource. JEB: Feature kind of overlap with event recorder
+	// Besides the lifecycle specific types, it holds the Ready, Reconciling
+	// and Stalled conditions understood by kubectl wait and kstatus.
 	Conditions []LcmResourceCondition `json:"conditions,omitempty"`
+	// ObservedGeneration is the generation of the spec the status reflects
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 }
 
 // PhaseStatus represents the common attributes shared amongst armada resources
@@ -142,15 +151,15 @@ type PhaseStatus struct {
 
 // SetCondition sets a condition on the status object. If the condition already
 // exists, it will be replaced. SetCondition does not update the resource in
-// the cluster.
-func (s *OpenstackLcmStatus) SetCondition(cond LcmResourceCondition, tgt LcmResourceState) {
+// the cluster. The condition is recorded even if ComputeActualState rejects it.
+func (s *OpenstackLcmStatus) SetCondition(cond LcmResourceCondition, tgt LcmResourceState) error {
 
 	// Add the condition to the list
 	chelper := LcmResourceConditionListHelper{Items: s.Conditions}
 	s.Conditions = chelper.SetCondition(cond)
 
 	// Recompute the state
-	s.ComputeActualState(cond, tgt)
+	return s.ComputeActualState(cond, tgt)
 }
 
 // RemoveCondition removes the condition with the passed condition type from
@@ -246,61 +255,19 @@ func (s *LcmResourceConditionListHelper) FindCondition(conditionType LcmResource
 	return found
 }
 
-func (s *OpenstackLcmStatus) ComputeActualState(cond LcmResourceCondition, target LcmResourceState) {
-	// TODO(Ian): finish this
-	if cond.Status == ConditionStatusTrue {
-		switch cond.Type {
-		case ConditionPending:
-			s.ActualState = StatePending
-			s.Succeeded = (s.ActualState == target)
-			s.Reason = ""
-		case ConditionInitialized:
-			// Since that condition is set almost systematically
-			// let's do not recompute the state.
-			if (s.ActualState == "") || (s.ActualState == StateUnknown) {
-				s.ActualState = StateInitialized
-				s.Succeeded = (s.ActualState == target)
-				s.Reason = ""
-			}
-		case ConditionRunning:
-			// The deployment is still running
-			s.ActualState = StateRunning
-			s.Succeeded = false
-			s.Reason = ""
-		case ConditionDeployed:
-			// No change is expected anymore. It is deployed
-			s.ActualState = StateDeployed
-			s.Succeeded = (s.ActualState == target)
-			s.Reason = ""
-		case ConditionFailed:
-			// No change is expected anymore. It is failed
-			s.ActualState = StateFailed
-			s.Succeeded = false
-			s.Reason = cond.Reason.String()
-		case ConditionIrreconcilable:
-			// We can't reconcile the subresources and the CRD
-			s.ActualState = StateError
-			s.Succeeded = false
-			s.Reason = cond.Reason.String()
-		case ConditionError:
-			// We have a bug somewhere.
-			s.ActualState = StateError
-			s.Succeeded = false
-			s.Reason = cond.Reason.String()
-		default:
-			s.Succeeded = (s.ActualState == target)
-			s.Reason = ""
-		}
-	} else {
-		if cond.Type == ConditionDeployed {
-			s.ActualState = StateUninstalled
-			s.Succeeded = (s.ActualState == target)
-			s.Reason = ""
-		} else {
-			s.Succeeded = (s.ActualState == target)
-			s.Reason = ""
-		}
-	}
+// ComputeActualState moves the actual state according to the condition and
+// recomputes Succeeded, see the transition table of the lifecycle package. A
+// condition contradicting the actual state leaves it unchanged and returns
+// a *lifecycle.RejectedTransitionError.
+func (s *OpenstackLcmStatus) ComputeActualState(cond LcmResourceCondition, target LcmResourceState) error {
+	res, err := lifecycle.Compute(
+		lifecycle.State(s.ActualState), s.Reason,
+		lifecycle.ConditionType(cond.Type), lifecycle.ConditionStatus(cond.Status), cond.Reason.String(),
+		lifecycle.State(target))
+	s.ActualState = LcmResourceState(res.State)
+	s.Succeeded = res.Satisfied
+	s.Reason = res.Reason
+	return err
 }
 
 // PhaseSource describe the location of the CR to create during a Phase of an
@@ -0,0 +1,128 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/keleustes/armada-crd/pkg/lifecycle"
+)
+
+// The SetDefaults_ functions are collected by defaulter-gen into
+// zz_generated.defaults.go, whose RegisterDefaults is added to the
+// SchemeBuilder. Use scheme.Default(obj) to apply them.
+
+func init() {
+	SchemeBuilder.SchemeBuilder.Register(RegisterDefaults)
+}
+
+// setStatusDefaults initializes a status which has never been reconciled
+func setStatusDefaults(s *OpenstackLcmStatus, target LcmResourceState) {
+	if s.ActualState == "" {
+		s.ActualState = StateUninitialized
+		s.Succeeded = lifecycle.Satisfied(lifecycle.State(s.ActualState), lifecycle.State(target))
+	}
+}
+
+// SetDefaults_Oslc sets the target state to deployed and initializes
+// the status.
+func SetDefaults_Oslc(obj *Oslc) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_PlanningPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_PlanningPhase(obj *PlanningPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_InstallPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_InstallPhase(obj *InstallPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_TestPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_TestPhase(obj *TestPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+	if obj.Status.TestResults == "" {
+		obj.Status.TestResults = TestResultsPassed
+	}
+}
+
+// SetDefaults_TrafficRolloutPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_TrafficRolloutPhase(obj *TrafficRolloutPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_OperationalPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_OperationalPhase(obj *OperationalPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_TrafficDrainPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_TrafficDrainPhase(obj *TrafficDrainPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_UpgradePhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_UpgradePhase(obj *UpgradePhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_RollbackPhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_RollbackPhase(obj *RollbackPhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
+
+// SetDefaults_DeletePhase sets the target state to deployed and initializes
+// the status.
+func SetDefaults_DeletePhase(obj *DeletePhase) {
+	if obj.Spec.TargetState == "" {
+		obj.Spec.TargetState = StateDeployed
+	}
+	setStatusDefaults(&obj.Status.OpenstackLcmStatus, obj.Spec.TargetState)
+}
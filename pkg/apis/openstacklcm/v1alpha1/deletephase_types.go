@@ -22,6 +22,7 @@ type DeletePhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DeletePhase is the Schema for the openstackdeployments API
@@ -39,18 +40,6 @@ type DeletePhase struct {
 	Status DeletePhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an DeletePhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *DeletePhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *DeletePhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
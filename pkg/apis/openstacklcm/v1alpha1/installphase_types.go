@@ -26,6 +26,7 @@ type InstallPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InstallPhase is the Schema for the openstackdeployments API
@@ -43,18 +44,6 @@ type InstallPhase struct {
 	Status InstallPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an InstallPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *InstallPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *InstallPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
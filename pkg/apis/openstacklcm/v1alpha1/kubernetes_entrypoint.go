@@ -15,9 +15,10 @@
 package v1alpha1
 
 import (
+	"errors"
 	"fmt"
-	"strings"
 
+	"github.com/keleustes/armada-crd/pkg/fieldpath"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,57 +34,11 @@ func (obj *KubernetesDependency) IsUnstructuredReady(u *unstructured.Unstructure
 		return true
 	}
 
-	// TODO(jeb): Any better pattern possible here ?
-	switch u.GetKind() {
-	case "Pod":
-		{
-			return obj.IsPodReady(u)
-		}
-	case "Job":
-		{
-			return obj.IsJobReady(u)
-		}
-	case "Service":
-		{
-			return true
-		}
-	case "Deployment":
-		{
-			return true
-		}
-	case "StatefulSet":
-		{
-			return true
-		}
-	case "Workflow":
-		{
-			return obj.IsWorkflowReady(u)
-		}
-	case "ArmadaChart":
-		{
-			return obj.IsArmadaChartReady(u)
-		}
-	case "ArmadaChartGroup":
-		{
-			return obj.IsArmadaChartGroupReady(u)
-		}
-	case "ArmadaManifest":
-		{
-			return obj.IsArmadaManifestReady(u)
-		}
-	// case "PodDisruptionBudget":
-	// case "ServiceAccount":
-	// case "Role":
-	// case "RoleBinding":
-	// case "Secret":
-	// case "ConfigMap":
-	// case "Ingress":
-	// case "CronJob":
-	default:
-		{
-			return true
-		}
+	checker := ReadinessCheckers.Lookup(u.GroupVersionKind())
+	if checker == nil {
+		return true
 	}
+	return checker.IsReady(u)
 }
 
 // Is the status of the Unstructured ready
@@ -92,57 +47,11 @@ func (obj *KubernetesDependency) IsUnstructuredFailedOrError(u *unstructured.Uns
 		return false
 	}
 
-	// TODO(jeb): Any better pattern possible here ?
-	switch u.GetKind() {
-	case "Pod":
-		{
-			return obj.IsPodFailedOrError(u)
-		}
-	case "Job":
-		{
-			return obj.IsJobFailedOrError(u)
-		}
-	case "Service":
-		{
-			return false
-		}
-	case "Deployment":
-		{
-			return false
-		}
-	case "StatefulSet":
-		{
-			return false
-		}
-	case "Workflow":
-		{
-			return obj.IsWorkflowFailedOrError(u)
-		}
-	case "ArmadaChart":
-		{
-			return obj.IsArmadaChartFailedOrError(u)
-		}
-	case "ArmadaChartGroup":
-		{
-			return obj.IsArmadaChartGroupFailedOrError(u)
-		}
-	case "ArmadaManifest":
-		{
-			return obj.IsArmadaManifestFailedOrError(u)
-		}
-	// case "PodDisruptionBudget":
-	// case "ServiceAccount":
-	// case "Role":
-	// case "RoleBinding":
-	// case "Secret":
-	// case "ConfigMap":
-	// case "Ingress":
-	// case "CronJob":
-	default:
-		{
-			return false
-		}
+	checker := ReadinessCheckers.Lookup(u.GroupVersionKind())
+	if checker == nil {
+		return false
 	}
+	return checker.IsFailedOrError(u)
 }
 
 // Did the status changed
@@ -151,71 +60,31 @@ func (obj *KubernetesDependency) UnstructuredStatusChanged(u *unstructured.Unstr
 		return true, "", ""
 	}
 
-	if u.GetKind() != v.GetKind() {
+	if u.GroupVersionKind() != v.GroupVersionKind() {
 		return false, "", ""
 	}
 
-	// TODO(jeb): Any better pattern possible here ?
-	switch u.GetKind() {
-	case "Pod":
-		{
-			return obj.PodStatusChanged(u, v)
-		}
-	case "Job":
-		{
-			return obj.JobStatusChanged(u, v)
-		}
-	case "Service":
-		{
-			return false, "", ""
-		}
-	case "Deployment":
-		{
-			return false, "", ""
-		}
-	case "StatefulSet":
-		{
-			return false, "", ""
-		}
-	case "Workflow":
-		{
-			return obj.WorkflowStatusChanged(u, v)
-		}
-	case "ArmadaChart":
-		{
-			return obj.ArmadaChartStatusChanged(u, v)
-		}
-	case "ArmadaChartGroup":
-		{
-			return obj.ArmadaChartGroupStatusChanged(u, v)
-		}
-	case "ArmadaManifest":
-		{
-			return obj.ArmadaManifestStatusChanged(u, v)
-		}
-	// case "PodDisruptionBudget":
-	// case "ServiceAccount":
-	// case "Role":
-	// case "RoleBinding":
-	// case "Secret":
-	// case "ConfigMap":
-	// case "Ingress":
-	// case "CronJob":
-	default:
-		{
-			return false, "", ""
-		}
+	checker := ReadinessCheckers.Lookup(u.GroupVersionKind())
+	if checker == nil {
+		return false, "", ""
 	}
+	return checker.StatusChanged(u, v)
 }
 
+// States of the armada resources, see HelmResourceState
+var (
+	armadaReadyStates  = []string{"deployed"}
+	armadaFailedStates = []string{"error", "failed"}
+)
+
 // Check the state of the ArmadaChart to figure out if it is still running
 func (obj *KubernetesDependency) IsArmadaChartReady(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Deployed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaReadyStates, u)
 }
 
 // Check the state of the ArmadaChart to figure out if it is still running
 func (obj *KubernetesDependency) IsArmadaChartFailedOrError(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Error", "Failed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaFailedStates, u)
 }
 
 func (obj *KubernetesDependency) ArmadaChartStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
@@ -224,12 +93,12 @@ func (obj *KubernetesDependency) ArmadaChartStatusChanged(u *unstructured.Unstru
 
 // Check the state of the ArmadaChartGroup to figure out if it is still running
 func (obj *KubernetesDependency) IsArmadaChartGroupReady(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Deployed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaReadyStates, u)
 }
 
 // Check the state of the ArmadaChartGroup to figure out if it failed
 func (obj *KubernetesDependency) IsArmadaChartGroupFailedOrError(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Error", "Failed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaFailedStates, u)
 }
 
 func (obj *KubernetesDependency) ArmadaChartGroupStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
@@ -238,12 +107,12 @@ func (obj *KubernetesDependency) ArmadaChartGroupStatusChanged(u *unstructured.U
 
 // Check the state of the ArmadaManifest to figure out if it is still running
 func (obj *KubernetesDependency) IsArmadaManifestReady(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Deployed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaReadyStates, u)
 }
 
 // Check the state of the ArmadaManifest to figure out if it failed
 func (obj *KubernetesDependency) IsArmadaManifestFailedOrError(u *unstructured.Unstructured) bool {
-	return obj.IsCustomResourceReady("status.actual_state", []string{"Error", "Failed"}, u)
+	return obj.IsCustomResourceReady("status.actual_state", armadaFailedStates, u)
 }
 
 func (obj *KubernetesDependency) ArmadaManifestStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
@@ -290,31 +159,28 @@ func (obj *KubernetesDependency) CustomResourceStatusChanged(key string,
 	return uValue != "" && vValue != "" && uValue != vValue, uValue, vValue
 }
 
-// Utility function to extract a field value from an Unstructured object
-// This code is inspired from the kubernetes-entrypoint project
-func (obj *KubernetesDependency) extractField(key string, u *unstructured.Unstructured) string {
-
+// ExtractField returns the value of a field of an Unstructured object,
+// formatted as a string. The key is a JSONPath or a CEL expression, see
+// the fieldpath package, which also defines the errors returned.
+func (obj *KubernetesDependency) ExtractField(key string, u *unstructured.Unstructured) (string, error) {
 	if u == nil {
-		return ""
+		return "", &fieldpath.NotFoundError{Expression: key}
 	}
+	return fieldpath.String(key, u.UnstructuredContent())
+}
 
-	customResource := u.UnstructuredContent()
-
-	for i := strings.Index(key, "."); i != -1; i = strings.Index(key, ".") {
-		first := key[:i]
-		key = key[i+1:]
-		if customResource[first] != nil {
-			customResource = customResource[first].(map[string]interface{})
-		} else {
-			return ""
+// Utility function to extract a field value from an Unstructured object,
+// "" if the field can not be extracted
+func (obj *KubernetesDependency) extractField(key string, u *unstructured.Unstructured) string {
+	value, err := obj.ExtractField(key, u)
+	if err != nil {
+		var notFound *fieldpath.NotFoundError
+		if !errors.As(err, &notFound) {
+			log.Info("Can not extract field", "key", key, "error", err.Error())
 		}
-	}
-
-	if customResource != nil {
-		return customResource[key].(string)
-	} else {
 		return ""
 	}
+	return value
 }
 
 // Check the state of a service
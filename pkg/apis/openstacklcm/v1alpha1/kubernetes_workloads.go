@@ -0,0 +1,160 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"github.com/keleustes/armada-crd/pkg/rollout"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// Check the state of a deployment
+func (obj *KubernetesDependency) IsDeploymentReady(u *unstructured.Unstructured) bool {
+	d, ok := toDeployment(u)
+	return ok && deploymentRollout(d) == rollout.Complete
+}
+
+func (obj *KubernetesDependency) IsDeploymentFailedOrError(u *unstructured.Unstructured) bool {
+	d, ok := toDeployment(u)
+	return ok && deploymentRollout(d) == rollout.Failed
+}
+
+// Compare the rollout state between two Deployment
+func (obj *KubernetesDependency) DeploymentStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
+	if u == nil || v == nil {
+		return true, "", ""
+	}
+
+	du, oku := toDeployment(u)
+	dv, okv := toDeployment(v)
+	if !oku || !okv {
+		return true, "", ""
+	}
+
+	stateu := deploymentRollout(du)
+	statev := deploymentRollout(dv)
+	return stateu != statev, string(stateu), string(statev)
+}
+
+// Check the state of a statefulset
+func (obj *KubernetesDependency) IsStatefulSetReady(u *unstructured.Unstructured) bool {
+	s, ok := toStatefulSet(u)
+	return ok && statefulSetRollout(s) == rollout.Complete
+}
+
+// A StatefulSet has no progress deadline, its rollout never fails
+func (obj *KubernetesDependency) IsStatefulSetFailedOrError(u *unstructured.Unstructured) bool {
+	return false
+}
+
+// Compare the rollout state between two StatefulSet
+func (obj *KubernetesDependency) StatefulSetStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
+	if u == nil || v == nil {
+		return true, "", ""
+	}
+
+	su, oku := toStatefulSet(u)
+	sv, okv := toStatefulSet(v)
+	if !oku || !okv {
+		return true, "", ""
+	}
+
+	stateu := statefulSetRollout(su)
+	statev := statefulSetRollout(sv)
+	return stateu != statev, string(stateu), string(statev)
+}
+
+// Check the state of a daemonset
+func (obj *KubernetesDependency) IsDaemonSetReady(u *unstructured.Unstructured) bool {
+	ds, ok := toDaemonSet(u)
+	return ok && daemonSetRollout(ds) == rollout.Complete
+}
+
+// A DaemonSet has no progress deadline, its rollout never fails
+func (obj *KubernetesDependency) IsDaemonSetFailedOrError(u *unstructured.Unstructured) bool {
+	return false
+}
+
+// Compare the rollout state between two DaemonSet
+func (obj *KubernetesDependency) DaemonSetStatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
+	if u == nil || v == nil {
+		return true, "", ""
+	}
+
+	dsu, oku := toDaemonSet(u)
+	dsv, okv := toDaemonSet(v)
+	if !oku || !okv {
+		return true, "", ""
+	}
+
+	stateu := daemonSetRollout(dsu)
+	statev := daemonSetRollout(dsv)
+	return stateu != statev, string(stateu), string(statev)
+}
+
+// deploymentRollout returns the rollout state of a Deployment, see
+// rollout.Deployment
+func deploymentRollout(d *appsv1.Deployment) rollout.State {
+	status, _ := rollout.Deployment(d, nil)
+	return status.State
+}
+
+// statefulSetRollout returns the rollout state of a StatefulSet, see
+// rollout.StatefulSet
+func statefulSetRollout(s *appsv1.StatefulSet) rollout.State {
+	status, _ := rollout.StatefulSet(s, nil)
+	return status.State
+}
+
+// daemonSetRollout returns the rollout state of a DaemonSet, see
+// rollout.DaemonSet
+func daemonSetRollout(ds *appsv1.DaemonSet) rollout.State {
+	status, _ := rollout.DaemonSet(ds, nil)
+	return status.State
+}
+
+func toDeployment(u *unstructured.Unstructured) (*appsv1.Deployment, bool) {
+	if u == nil {
+		return nil, false
+	}
+	d := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), d); err != nil {
+		return nil, false
+	}
+	return d, true
+}
+
+func toStatefulSet(u *unstructured.Unstructured) (*appsv1.StatefulSet, bool) {
+	if u == nil {
+		return nil, false
+	}
+	s := &appsv1.StatefulSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), s); err != nil {
+		return nil, false
+	}
+	return s, true
+}
+
+func toDaemonSet(u *unstructured.Unstructured) (*appsv1.DaemonSet, bool) {
+	if u == nil {
+		return nil, false
+	}
+	ds := &appsv1.DaemonSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), ds); err != nil {
+		return nil, false
+	}
+	return ds, true
+}
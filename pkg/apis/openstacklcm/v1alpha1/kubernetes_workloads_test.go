@@ -0,0 +1,248 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// Fixture objects, as returned by the API server
+const (
+	deploymentComplete = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 2}
+spec: {replicas: 3}
+status: {observedGeneration: 2, replicas: 3, updatedReplicas: 3, readyReplicas: 3, availableReplicas: 3}
+`
+	deploymentNotObserved = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 3}
+spec: {replicas: 3}
+status: {observedGeneration: 2, replicas: 3, updatedReplicas: 3, readyReplicas: 3, availableReplicas: 3}
+`
+	deploymentUpdating = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 3}
+spec: {replicas: 3}
+status: {observedGeneration: 3, replicas: 3, updatedReplicas: 1, readyReplicas: 3, availableReplicas: 3}
+`
+	deploymentTerminating = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 3}
+spec: {replicas: 3}
+status: {observedGeneration: 3, replicas: 4, updatedReplicas: 3, readyReplicas: 3, availableReplicas: 3}
+`
+	deploymentUnavailable = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 3}
+spec: {replicas: 3}
+status: {observedGeneration: 3, replicas: 3, updatedReplicas: 3, readyReplicas: 2, availableReplicas: 2}
+`
+	deploymentDefaultReplicas = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 1}
+status: {observedGeneration: 1}
+`
+	deploymentDeadlineExceeded = `
+apiVersion: apps/v1
+kind: Deployment
+metadata: {name: api, generation: 3}
+spec: {replicas: 3}
+status:
+  observedGeneration: 3
+  replicas: 4
+  updatedReplicas: 1
+  availableReplicas: 3
+  conditions:
+  - {type: Progressing, status: "False", reason: ProgressDeadlineExceeded}
+`
+
+	statefulSetComplete = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 2}
+spec: {replicas: 3}
+status: {observedGeneration: 2, replicas: 3, readyReplicas: 3, updatedReplicas: 3, currentRevision: db-2, updateRevision: db-2}
+`
+	statefulSetNotObserved = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 1}
+spec: {replicas: 3}
+status: {replicas: 3, readyReplicas: 3}
+`
+	statefulSetNotReady = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 2}
+spec: {replicas: 3}
+status: {observedGeneration: 2, replicas: 3, readyReplicas: 2, updatedReplicas: 3, currentRevision: db-2, updateRevision: db-2}
+`
+	statefulSetUpdating = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 3}
+spec: {replicas: 3}
+status: {observedGeneration: 3, replicas: 3, readyReplicas: 3, updatedReplicas: 1, currentRevision: db-2, updateRevision: db-3}
+`
+	statefulSetPartitioned = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 3}
+spec:
+  replicas: 3
+  updateStrategy: {type: RollingUpdate, rollingUpdate: {partition: 2}}
+status: {observedGeneration: 3, replicas: 3, readyReplicas: 3, updatedReplicas: 1, currentRevision: db-2, updateRevision: db-3}
+`
+	statefulSetPartitionUpdating = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 3}
+spec:
+  replicas: 3
+  updateStrategy: {type: RollingUpdate, rollingUpdate: {partition: 1}}
+status: {observedGeneration: 3, replicas: 3, readyReplicas: 3, updatedReplicas: 1, currentRevision: db-2, updateRevision: db-3}
+`
+	statefulSetOnDelete = `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata: {name: db, generation: 3}
+spec:
+  replicas: 3
+  updateStrategy: {type: OnDelete}
+status: {observedGeneration: 3, replicas: 3, readyReplicas: 3, currentRevision: db-2, updateRevision: db-3}
+`
+
+	daemonSetComplete = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent, generation: 2}
+status: {observedGeneration: 2, desiredNumberScheduled: 4, updatedNumberScheduled: 4, numberAvailable: 4}
+`
+	daemonSetNotObserved = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent, generation: 3}
+status: {observedGeneration: 2, desiredNumberScheduled: 4, updatedNumberScheduled: 4, numberAvailable: 4}
+`
+	daemonSetUpdating = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent, generation: 3}
+status: {observedGeneration: 3, desiredNumberScheduled: 4, updatedNumberScheduled: 2, numberAvailable: 4}
+`
+	daemonSetUnavailable = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent, generation: 3}
+status: {observedGeneration: 3, desiredNumberScheduled: 4, updatedNumberScheduled: 4, numberAvailable: 4, numberUnavailable: 1}
+`
+	daemonSetOnDelete = `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata: {name: agent, generation: 3}
+spec:
+  updateStrategy: {type: OnDelete}
+status: {observedGeneration: 3, desiredNumberScheduled: 4, updatedNumberScheduled: 1, numberAvailable: 4}
+`
+)
+
+func newFixture(t *testing.T, fixture string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(fixture), &u.Object); err != nil {
+		t.Fatalf("invalid fixture: %v", err)
+	}
+	return u
+}
+
+func TestWorkloadReadiness(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		ready   bool
+		failed  bool
+	}{
+		{"deployment complete", deploymentComplete, true, false},
+		{"deployment not observed", deploymentNotObserved, false, false},
+		{"deployment updating", deploymentUpdating, false, false},
+		{"deployment terminating old replicas", deploymentTerminating, false, false},
+		{"deployment unavailable", deploymentUnavailable, false, false},
+		{"deployment default replicas", deploymentDefaultReplicas, false, false},
+		{"deployment deadline exceeded", deploymentDeadlineExceeded, false, true},
+		{"statefulset complete", statefulSetComplete, true, false},
+		{"statefulset not observed", statefulSetNotObserved, false, false},
+		{"statefulset not ready", statefulSetNotReady, false, false},
+		{"statefulset updating", statefulSetUpdating, false, false},
+		{"statefulset partitioned", statefulSetPartitioned, true, false},
+		{"statefulset partition updating", statefulSetPartitionUpdating, false, false},
+		{"statefulset on delete", statefulSetOnDelete, true, false},
+		{"daemonset complete", daemonSetComplete, true, false},
+		{"daemonset not observed", daemonSetNotObserved, false, false},
+		{"daemonset updating", daemonSetUpdating, false, false},
+		{"daemonset unavailable", daemonSetUnavailable, false, false},
+		{"daemonset on delete", daemonSetOnDelete, true, false},
+	}
+
+	dep := &KubernetesDependency{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			u := newFixture(t, tt.fixture)
+			g.Expect(dep.IsUnstructuredReady(u)).To(gomega.Equal(tt.ready))
+			g.Expect(dep.IsUnstructuredFailedOrError(u)).To(gomega.Equal(tt.failed))
+		})
+	}
+}
+
+func TestWorkloadStatusChanged(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		changed bool
+		fromVal string
+		toVal   string
+	}{
+		{"deployment rolled out", deploymentUpdating, deploymentComplete, true, "Progressing", "Complete"},
+		{"deployment still progressing", deploymentUpdating, deploymentTerminating, false, "Progressing", "Progressing"},
+		{"deployment deadline exceeded", deploymentUpdating, deploymentDeadlineExceeded, true, "Progressing", "Failed"},
+		{"deployment new generation", deploymentComplete, deploymentNotObserved, true, "Complete", "Progressing"},
+		{"statefulset rolled out", statefulSetUpdating, statefulSetComplete, true, "Progressing", "Complete"},
+		{"statefulset unchanged", statefulSetComplete, statefulSetComplete, false, "Complete", "Complete"},
+		{"daemonset rolled out", daemonSetUnavailable, daemonSetComplete, true, "Progressing", "Complete"},
+		{"daemonset still progressing", daemonSetUpdating, daemonSetUnavailable, false, "Progressing", "Progressing"},
+	}
+
+	dep := &KubernetesDependency{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			changed, fromVal, toVal := dep.UnstructuredStatusChanged(newFixture(t, tt.from), newFixture(t, tt.to))
+			g.Expect(changed).To(gomega.Equal(tt.changed))
+			g.Expect(fromVal).To(gomega.Equal(tt.fromVal))
+			g.Expect(toVal).To(gomega.Equal(tt.toVal))
+		})
+	}
+}
@@ -31,6 +31,7 @@ type OperationalPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // OperationalPhase is the Schema for the openstackdeployments API
@@ -48,18 +49,6 @@ type OperationalPhase struct {
 	Status OperationalPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an OperationalPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *OperationalPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *OperationalPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -80,6 +80,7 @@ type OslcStatus struct {
 	OpenstackLcmStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // Oslc is the Schema for the openstackdeployments API
@@ -97,18 +98,6 @@ type Oslc struct {
 	Status OslcStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an Oslc. Namely, if the state has not been
-// specified, it will be set
-func (obj *Oslc) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *Oslc) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
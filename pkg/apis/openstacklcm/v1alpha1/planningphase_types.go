@@ -19,6 +19,7 @@ type PlanningPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // PlanningPhase is the Schema for the openstackdeployments API
@@ -36,18 +37,6 @@ type PlanningPhase struct {
 	Status PlanningPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an PlanningPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *PlanningPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *PlanningPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
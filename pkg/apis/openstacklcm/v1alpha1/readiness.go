@@ -0,0 +1,284 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/keleustes/armada-crd/pkg/fieldpath"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/yaml"
+)
+
+// ReadinessChecker evaluates the status of the objects of one kind
+// +k8s:deepcopy-gen=false
+type ReadinessChecker interface {
+	IsReady(u *unstructured.Unstructured) bool
+	IsFailedOrError(u *unstructured.Unstructured) bool
+	// StatusChanged returns true if the status changed between u and v,
+	// along with the status of each
+	StatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string)
+}
+
+// ReadinessFuncs adapts functions to a ReadinessChecker
+// +k8s:deepcopy-gen=false
+type ReadinessFuncs struct {
+	Ready         func(u *unstructured.Unstructured) bool
+	FailedOrError func(u *unstructured.Unstructured) bool
+	Changed       func(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string)
+}
+
+func (f ReadinessFuncs) IsReady(u *unstructured.Unstructured) bool {
+	if f.Ready == nil {
+		return true
+	}
+	return f.Ready(u)
+}
+
+func (f ReadinessFuncs) IsFailedOrError(u *unstructured.Unstructured) bool {
+	if f.FailedOrError == nil {
+		return false
+	}
+	return f.FailedOrError(u)
+}
+
+func (f ReadinessFuncs) StatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
+	if f.Changed == nil {
+		return false, "", ""
+	}
+	return f.Changed(u, v)
+}
+
+// FieldReadinessChecker checks a custom resource by the value of one of its
+// status fields, for instance "status.phase", or by predicates. The paths
+// and predicates are JSONPath or CEL expressions, see the fieldpath package.
+// +k8s:deepcopy-gen=false
+type FieldReadinessChecker struct {
+	// FieldPath is the path of the field
+	FieldPath string `json:"fieldPath,omitempty"`
+	// ReadyValues are the values meaning the resource is ready
+	ReadyValues []string `json:"readyValues,omitempty"`
+	// FailedValues are the values meaning the resource failed
+	FailedValues []string `json:"failedValues,omitempty"`
+	// ReadyWhen is a predicate true when the resource is ready, checked
+	// instead of ReadyValues
+	ReadyWhen string `json:"readyWhen,omitempty"`
+	// FailedWhen is a predicate true when the resource failed, checked
+	// instead of FailedValues
+	FailedWhen string `json:"failedWhen,omitempty"`
+}
+
+func (c *FieldReadinessChecker) IsReady(u *unstructured.Unstructured) bool {
+	if c.ReadyWhen != "" {
+		return c.holds(c.ReadyWhen, u)
+	}
+	dep := &KubernetesDependency{}
+	return dep.IsCustomResourceReady(c.FieldPath, c.ReadyValues, u)
+}
+
+func (c *FieldReadinessChecker) IsFailedOrError(u *unstructured.Unstructured) bool {
+	if c.FailedWhen != "" {
+		return c.holds(c.FailedWhen, u)
+	}
+	dep := &KubernetesDependency{}
+	return len(c.FailedValues) > 0 && dep.IsCustomResourceReady(c.FieldPath, c.FailedValues, u)
+}
+
+// StatusChanged compares the field, or without field the outcome of the
+// predicates
+func (c *FieldReadinessChecker) StatusChanged(u *unstructured.Unstructured, v *unstructured.Unstructured) (bool, string, string) {
+	dep := &KubernetesDependency{}
+	if c.FieldPath != "" {
+		return dep.CustomResourceStatusChanged(c.FieldPath, u, v)
+	}
+	stateu := c.state(u)
+	statev := c.state(v)
+	return stateu != statev, stateu, statev
+}
+
+func (c *FieldReadinessChecker) state(u *unstructured.Unstructured) string {
+	switch {
+	case c.IsFailedOrError(u):
+		return "Failed"
+	case c.IsReady(u):
+		return "Ready"
+	}
+	return "Pending"
+}
+
+// holds evaluates a predicate, false if it can not be evaluated
+func (c *FieldReadinessChecker) holds(predicate string, u *unstructured.Unstructured) bool {
+	if u == nil {
+		return false
+	}
+	res, err := fieldpath.Bool(predicate, u.UnstructuredContent())
+	return err == nil && res
+}
+
+// Validate checks the checker declares how to be ready and compiles its
+// expressions
+func (c *FieldReadinessChecker) Validate() error {
+	if c.ReadyWhen == "" && (c.FieldPath == "" || len(c.ReadyValues) == 0) {
+		return fmt.Errorf("either readyWhen or fieldPath and readyValues are required")
+	}
+	if len(c.FailedValues) > 0 && c.FieldPath == "" {
+		return fmt.Errorf("failedValues requires fieldPath")
+	}
+	for _, expr := range []string{c.FieldPath, c.ReadyWhen, c.FailedWhen} {
+		if expr == "" {
+			continue
+		}
+		if _, err := fieldpath.Compile(expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadinessCheckerConfig declares a FieldReadinessChecker for a kind. An
+// empty Version matches every version of the group.
+// +k8s:deepcopy-gen=false
+type ReadinessCheckerConfig struct {
+	Group                 string `json:"group,omitempty"`
+	Version               string `json:"version,omitempty"`
+	Kind                  string `json:"kind"`
+	FieldReadinessChecker `json:",inline"`
+}
+
+// GroupVersionKind returns the kind the checker is registered for
+func (c *ReadinessCheckerConfig) GroupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: c.Group, Version: c.Version, Kind: c.Kind}
+}
+
+// Validate checks the mandatory fields of the configuration
+func (c *ReadinessCheckerConfig) Validate() error {
+	if c.Kind == "" {
+		return fmt.Errorf("readiness checker: kind is required")
+	}
+	if err := c.FieldReadinessChecker.Validate(); err != nil {
+		return fmt.Errorf("readiness checker for %s: %v", c.Kind, err)
+	}
+	return nil
+}
+
+// ReadinessRegistry holds the ReadinessChecker of each kind. A checker
+// registered with an empty version applies to every version of its group
+// and kind which has no checker of its own. The objects of the kinds
+// without checker are ready, never fail and never change.
+// +k8s:deepcopy-gen=false
+type ReadinessRegistry struct {
+	mu       sync.RWMutex
+	checkers map[schema.GroupVersionKind]ReadinessChecker
+}
+
+// NewReadinessRegistry returns an empty registry
+func NewReadinessRegistry() *ReadinessRegistry {
+	return &ReadinessRegistry{checkers: make(map[schema.GroupVersionKind]ReadinessChecker)}
+}
+
+// Register sets the checker of a kind, replacing the previous one
+func (r *ReadinessRegistry) Register(gvk schema.GroupVersionKind, checker ReadinessChecker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checkers[gvk] = checker
+}
+
+// Unregister removes the checker of a kind
+func (r *ReadinessRegistry) Unregister(gvk schema.GroupVersionKind) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.checkers, gvk)
+}
+
+// Lookup returns the checker of a kind, nil if there is none
+func (r *ReadinessRegistry) Lookup(gvk schema.GroupVersionKind) ReadinessChecker {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if checker, ok := r.checkers[gvk]; ok {
+		return checker
+	}
+	return r.checkers[gvk.GroupKind().WithVersion("")]
+}
+
+// RegisterConfig registers a FieldReadinessChecker for each configuration
+func (r *ReadinessRegistry) RegisterConfig(configs ...ReadinessCheckerConfig) error {
+	for i := range configs {
+		if err := configs[i].Validate(); err != nil {
+			return err
+		}
+	}
+	for i := range configs {
+		checker := configs[i].FieldReadinessChecker
+		r.Register(configs[i].GroupVersionKind(), &checker)
+	}
+	return nil
+}
+
+// Load registers the checkers declared by a YAML or JSON list of
+// ReadinessCheckerConfig
+func (r *ReadinessRegistry) Load(data []byte) error {
+	configs := make([]ReadinessCheckerConfig, 0)
+	if err := yaml.UnmarshalStrict(data, &configs); err != nil {
+		return fmt.Errorf("readiness checkers: %v", err)
+	}
+	return r.RegisterConfig(configs...)
+}
+
+// ReadinessCheckers is the registry used by KubernetesDependency
+var ReadinessCheckers = NewReadinessRegistry()
+
+// RegisterReadinessChecker sets the checker of a kind in ReadinessCheckers
+func RegisterReadinessChecker(gvk schema.GroupVersionKind, checker ReadinessChecker) {
+	ReadinessCheckers.Register(gvk, checker)
+}
+
+func init() {
+	dep := &KubernetesDependency{}
+	core := func(version string, kind string) schema.GroupVersionKind {
+		return schema.GroupVersionKind{Version: version, Kind: kind}
+	}
+
+	RegisterReadinessChecker(core("v1", "Pod"),
+		ReadinessFuncs{Ready: dep.IsPodReady, FailedOrError: dep.IsPodFailedOrError, Changed: dep.PodStatusChanged})
+	RegisterReadinessChecker(core("v1", "Service"), ReadinessFuncs{})
+	RegisterReadinessChecker(schema.GroupVersionKind{Group: "batch", Version: "v1", Kind: "Job"},
+		ReadinessFuncs{Ready: dep.IsJobReady, FailedOrError: dep.IsJobFailedOrError, Changed: dep.JobStatusChanged})
+
+	apps := func(kind string) schema.GroupVersionKind {
+		return schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: kind}
+	}
+	RegisterReadinessChecker(apps("Deployment"),
+		ReadinessFuncs{Ready: dep.IsDeploymentReady, FailedOrError: dep.IsDeploymentFailedOrError, Changed: dep.DeploymentStatusChanged})
+	RegisterReadinessChecker(apps("StatefulSet"),
+		ReadinessFuncs{Ready: dep.IsStatefulSetReady, FailedOrError: dep.IsStatefulSetFailedOrError, Changed: dep.StatefulSetStatusChanged})
+	RegisterReadinessChecker(apps("DaemonSet"),
+		ReadinessFuncs{Ready: dep.IsDaemonSetReady, FailedOrError: dep.IsDaemonSetFailedOrError, Changed: dep.DaemonSetStatusChanged})
+
+	RegisterReadinessChecker(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Workflow"},
+		ReadinessFuncs{Ready: dep.IsWorkflowReady, FailedOrError: dep.IsWorkflowFailedOrError, Changed: dep.WorkflowStatusChanged})
+
+	// The armada v1beta1 API names the state field in camelCase
+	armada := func(version string, kind string) schema.GroupVersionKind {
+		return schema.GroupVersionKind{Group: "armada.airshipit.org", Version: version, Kind: kind}
+	}
+	for _, kind := range []string{"ArmadaChart", "ArmadaChartGroup", "ArmadaManifest"} {
+		RegisterReadinessChecker(armada("v1alpha1", kind), &FieldReadinessChecker{
+			FieldPath: "status.actual_state", ReadyValues: armadaReadyStates, FailedValues: armadaFailedStates})
+		RegisterReadinessChecker(armada("v1beta1", kind), &FieldReadinessChecker{
+			FieldPath: "status.actualState", ReadyValues: armadaReadyStates, FailedValues: armadaFailedStates})
+	}
+}
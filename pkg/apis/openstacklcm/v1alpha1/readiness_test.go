@@ -0,0 +1,173 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/keleustes/armada-crd/pkg/fieldpath"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const readinessConfig = `
+- group: example.com
+  kind: Database
+  fieldPath: status.phase
+  readyValues: [Ready]
+  failedValues: [Failed, Lost]
+- group: example.com
+  version: v2
+  kind: Database
+  fieldPath: status.state
+  readyValues: [up]
+`
+
+func newResource(apiVersion string, kind string, status map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{"status": status}}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	return u
+}
+
+func TestReadinessRegistryLoad(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	registry := NewReadinessRegistry()
+	g.Expect(registry.Load([]byte(readinessConfig))).To(gomega.Succeed())
+
+	v1 := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+	v2 := v1.GroupKind().WithVersion("v2")
+	g.Expect(registry.Lookup(v1)).NotTo(gomega.BeNil())
+	g.Expect(registry.Lookup(v2)).NotTo(gomega.BeNil())
+	g.Expect(registry.Lookup(schema.GroupVersionKind{Group: "example.org", Version: "v1", Kind: "Database"})).To(gomega.BeNil())
+
+	ready := newResource("example.com/v1", "Database", map[string]interface{}{"phase": "Ready"})
+	lost := newResource("example.com/v1", "Database", map[string]interface{}{"phase": "Lost"})
+	g.Expect(registry.Lookup(v1).IsReady(ready)).To(gomega.BeTrue())
+	g.Expect(registry.Lookup(v1).IsFailedOrError(lost)).To(gomega.BeTrue())
+	changed, from, to := registry.Lookup(v1).StatusChanged(ready, lost)
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(from).To(gomega.Equal("Ready"))
+	g.Expect(to).To(gomega.Equal("Lost"))
+
+	// The v2 checker overrides the one of every version
+	up := newResource("example.com/v2", "Database", map[string]interface{}{"state": "up"})
+	g.Expect(registry.Lookup(v2).IsReady(up)).To(gomega.BeTrue())
+	g.Expect(registry.Lookup(v2).IsFailedOrError(up)).To(gomega.BeFalse())
+
+	g.Expect(registry.Load([]byte("- kind: Database\n  readyValues: [Ready]\n"))).NotTo(gomega.Succeed())
+	g.Expect(registry.Load([]byte("- kind: Database\n  fieldPath: status.phase\n"))).NotTo(gomega.Succeed())
+	g.Expect(registry.Load([]byte("- kind: Database\n  fieldPath: status.phase\n  readyValue: [Ready]\n"))).NotTo(gomega.Succeed())
+}
+
+func TestKubernetesDependencyRegistry(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	dep := &KubernetesDependency{}
+
+	// Kinds are matched on their group and version too
+	g.Expect(dep.IsUnstructuredReady(newFixture(t, deploymentUpdating))).To(gomega.BeFalse())
+	legacy := newFixture(t, deploymentUpdating)
+	legacy.SetAPIVersion("extensions/v1beta1")
+	g.Expect(dep.IsUnstructuredReady(legacy)).To(gomega.BeTrue())
+
+	alpha := newResource("armada.airshipit.org/v1alpha1", "ArmadaChart", map[string]interface{}{"actual_state": "deployed"})
+	beta := newResource("armada.airshipit.org/v1beta1", "ArmadaChart", map[string]interface{}{"actualState": "failed"})
+	g.Expect(dep.IsUnstructuredReady(alpha)).To(gomega.BeTrue())
+	g.Expect(dep.IsUnstructuredReady(beta)).To(gomega.BeFalse())
+	g.Expect(dep.IsUnstructuredFailedOrError(beta)).To(gomega.BeTrue())
+
+	// Third party kinds
+	gvk := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Database"}
+	database := newResource("example.com/v1", "Database", map[string]interface{}{"phase": "Creating"})
+	g.Expect(dep.IsUnstructuredReady(database)).To(gomega.BeTrue())
+	RegisterReadinessChecker(gvk, &FieldReadinessChecker{FieldPath: "status.phase", ReadyValues: []string{"Ready"}})
+	defer ReadinessCheckers.Unregister(gvk)
+	g.Expect(dep.IsUnstructuredReady(database)).To(gomega.BeFalse())
+	g.Expect(dep.IsUnstructuredFailedOrError(database)).To(gomega.BeFalse())
+}
+
+func TestCustomResourceExtraction(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	dep := &KubernetesDependency{}
+
+	odd := newResource("example.com/v1", "Database", map[string]interface{}{
+		"phase":      map[string]interface{}{"name": "Ready"},
+		"replicas":   int64(2),
+		"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+	})
+	// Neither a map nor a string is where a string is expected: no panic
+	g.Expect(dep.IsCustomResourceReady("status.phase", []string{"Ready"}, odd)).To(gomega.BeFalse())
+	g.Expect(dep.IsCustomResourceReady("status.conditions.status", []string{"True"}, odd)).To(gomega.BeFalse())
+	g.Expect(dep.IsCustomResourceReady("status.replicas", []string{"2"}, odd)).To(gomega.BeTrue())
+	g.Expect(dep.IsCustomResourceReady(`status.conditions[?(@.type=="Ready")].status`, []string{"True"}, odd)).To(gomega.BeTrue())
+	g.Expect(dep.IsCustomResourceReady("cel:self.status.replicas > 1", []string{"true"}, odd)).To(gomega.BeTrue())
+
+	_, err := dep.ExtractField("status.phase", odd)
+	var typeErr *fieldpath.TypeError
+	g.Expect(errors.As(err, &typeErr)).To(gomega.BeTrue())
+	_, err = dep.ExtractField("status.missing", odd)
+	var notFound *fieldpath.NotFoundError
+	g.Expect(errors.As(err, &notFound)).To(gomega.BeTrue())
+
+	changed, _, _ := dep.CustomResourceStatusChanged("status.phase", odd, odd)
+	g.Expect(changed).To(gomega.BeFalse())
+}
+
+const predicateConfig = `
+- group: example.com
+  kind: Cluster
+  readyWhen: cel:self.status.conditions.exists(c, c.type == "Ready" && c.status == "True")
+  failedWhen: status.conditions[?(@.type=="Stalled")].status == "True"
+`
+
+func TestReadinessPredicates(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	registry := NewReadinessRegistry()
+	g.Expect(registry.Load([]byte(predicateConfig))).NotTo(gomega.Succeed())
+	g.Expect(registry.Load([]byte(strings.Replace(predicateConfig,
+		`status.conditions[?(@.type=="Stalled")].status == "True"`,
+		`cel:self.status.conditions.exists(c, c.type == "Stalled" && c.status == "True")`, 1)))).To(gomega.Succeed())
+	g.Expect(registry.Load([]byte("- kind: Cluster\n  readyWhen: 'cel:self.status.'\n"))).NotTo(gomega.Succeed())
+	g.Expect(registry.Load([]byte("- kind: Cluster\n  readyWhen: status.ready\n  failedValues: [Failed]\n"))).NotTo(gomega.Succeed())
+
+	checker := registry.Lookup(schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Cluster"})
+	g.Expect(checker).NotTo(gomega.BeNil())
+	condition := func(kind string, status string) map[string]interface{} {
+		return map[string]interface{}{"conditions": []interface{}{map[string]interface{}{"type": kind, "status": status}}}
+	}
+	pending := newResource("example.com/v1", "Cluster", condition("Ready", "False"))
+	ready := newResource("example.com/v1", "Cluster", condition("Ready", "True"))
+	stalled := newResource("example.com/v1", "Cluster", condition("Stalled", "True"))
+	empty := newResource("example.com/v1", "Cluster", map[string]interface{}{})
+
+	g.Expect(checker.IsReady(pending)).To(gomega.BeFalse())
+	g.Expect(checker.IsReady(ready)).To(gomega.BeTrue())
+	g.Expect(checker.IsReady(empty)).To(gomega.BeFalse())
+	g.Expect(checker.IsFailedOrError(stalled)).To(gomega.BeTrue())
+	g.Expect(checker.IsFailedOrError(ready)).To(gomega.BeFalse())
+
+	changed, from, to := checker.StatusChanged(pending, ready)
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(from).To(gomega.Equal("Pending"))
+	g.Expect(to).To(gomega.Equal("Ready"))
+	changed, _, to = checker.StatusChanged(ready, stalled)
+	g.Expect(changed).To(gomega.BeTrue())
+	g.Expect(to).To(gomega.Equal("Failed"))
+}
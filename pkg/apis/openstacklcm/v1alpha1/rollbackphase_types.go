@@ -74,6 +74,7 @@ type RollbackPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // RollbackPhase is the Schema for the openstackdeployments API
@@ -91,18 +92,6 @@ type RollbackPhase struct {
 	Status RollbackPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an RollbackPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *RollbackPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *RollbackPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -0,0 +1,163 @@
+// Copyright 2019 The OpenstackLcm Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Standard condition types, as understood by "kubectl wait --for=condition=Ready"
+// and by kstatus. They live in the same list as the lifecycle specific ones.
+const (
+	// Ready is True once the actual state satisfies the target state
+	ConditionReady LcmResourceConditionType = "Ready"
+	// Reconciling is True while the controller works toward the target state
+	ConditionReconciling LcmResourceConditionType = "Reconciling"
+	// Stalled is True when the controller can not progress without help
+	ConditionStalled LcmResourceConditionType = "Stalled"
+)
+
+// Reasons of the standard conditions
+const (
+	ReasonSucceeded   LcmResourceConditionReason = "Succeeded"
+	ReasonProgressing LcmResourceConditionReason = "Progressing"
+	ReasonStalled     LcmResourceConditionReason = "Stalled"
+)
+
+// IsStandardConditionType returns true for Ready, Reconciling and Stalled
+func IsStandardConditionType(t LcmResourceConditionType) bool {
+	return t == ConditionReady || t == ConditionReconciling || t == ConditionStalled
+}
+
+// ToCondition converts the condition into a metav1.Condition. ResourceName
+// and ResourceVersion have no equivalent and are dropped.
+func (c LcmResourceCondition) ToCondition() metav1.Condition {
+	return metav1.Condition{
+		Type:               c.Type.String(),
+		Status:             metav1.ConditionStatus(c.Status),
+		ObservedGeneration: c.ObservedGeneration,
+		LastTransitionTime: c.LastTransitionTime,
+		Reason:             c.Reason.String(),
+		Message:            c.Message,
+	}
+}
+
+// NewLcmResourceCondition converts a metav1.Condition
+func NewLcmResourceCondition(c metav1.Condition) LcmResourceCondition {
+	return LcmResourceCondition{
+		Type:               LcmResourceConditionType(c.Type),
+		Status:             LcmResourceConditionStatus(c.Status),
+		Reason:             LcmResourceConditionReason(c.Reason),
+		Message:            c.Message,
+		LastTransitionTime: c.LastTransitionTime,
+		ObservedGeneration: c.ObservedGeneration,
+	}
+}
+
+// ToConditions converts a legacy condition list, so that it can be used
+// with the helpers of k8s.io/apimachinery/pkg/api/meta.
+func ToConditions(items []LcmResourceCondition) []metav1.Condition {
+	res := make([]metav1.Condition, 0, len(items))
+	for _, item := range items {
+		res = append(res, item.ToCondition())
+	}
+	return res
+}
+
+// FromConditions converts a metav1.Condition list into a legacy one
+func FromConditions(items []metav1.Condition) []LcmResourceCondition {
+	res := make([]LcmResourceCondition, 0, len(items))
+	for _, item := range items {
+		res = append(res, NewLcmResourceCondition(item))
+	}
+	return res
+}
+
+// GetStandardCondition returns the condition of the given type, nil if absent
+func (s *OpenstackLcmStatus) GetStandardCondition(conditionType LcmResourceConditionType) *metav1.Condition {
+	for _, cond := range s.Conditions {
+		if cond.Type == conditionType {
+			res := cond.ToCondition()
+			return &res
+		}
+	}
+	return nil
+}
+
+// IsStandardConditionTrue returns true if the condition is present and True
+func (s *OpenstackLcmStatus) IsStandardConditionTrue(conditionType LcmResourceConditionType) bool {
+	cond := s.GetStandardCondition(conditionType)
+	return cond != nil && cond.Status == metav1.ConditionTrue
+}
+
+// SetStandardCondition adds or replaces a condition. Unlike SetCondition
+// it does not recompute the actual state. The transition time is kept
+// when the status does not change.
+func (s *OpenstackLcmStatus) SetStandardCondition(cond metav1.Condition) {
+	chelper := LcmResourceConditionListHelper{Items: s.Conditions}
+	s.Conditions = chelper.SetCondition(NewLcmResourceCondition(cond))
+}
+
+// ComputeStandardConditions records the generation of the spec and derives
+// the Ready, Reconciling and Stalled conditions from the actual state:
+//   - Ready when the target state is satisfied,
+//   - Stalled in the failed and error states,
+//   - Reconciling otherwise.
+func (s *OpenstackLcmStatus) ComputeStandardConditions(generation int64) {
+	s.ObservedGeneration = generation
+
+	ready := metav1.Condition{Type: ConditionReady.String(), Status: metav1.ConditionFalse, ObservedGeneration: generation}
+	reconciling := metav1.Condition{Type: ConditionReconciling.String(), Status: metav1.ConditionFalse, ObservedGeneration: generation}
+	stalled := metav1.Condition{Type: ConditionStalled.String(), Status: metav1.ConditionFalse, ObservedGeneration: generation}
+
+	switch {
+	case s.Succeeded:
+		ready.Status = metav1.ConditionTrue
+		ready.Reason = ReasonSucceeded.String()
+		ready.Message = "actual state " + s.ActualState.String()
+		reconciling.Reason = ReasonSucceeded.String()
+		stalled.Reason = ReasonSucceeded.String()
+	case s.ActualState == StateFailed || s.ActualState == StateError:
+		ready.Reason = ReasonStalled.String()
+		reconciling.Reason = ReasonStalled.String()
+		stalled.Status = metav1.ConditionTrue
+		stalled.Reason = ReasonStalled.String()
+		stalled.Message = s.Reason
+		if stalled.Message == "" {
+			stalled.Message = "actual state " + s.ActualState.String()
+		}
+	default:
+		ready.Reason = ReasonProgressing.String()
+		reconciling.Status = metav1.ConditionTrue
+		reconciling.Reason = ReasonProgressing.String()
+		reconciling.Message = "actual state " + s.ActualState.String()
+		stalled.Reason = ReasonProgressing.String()
+	}
+
+	s.SetStandardCondition(ready)
+	s.SetStandardCondition(reconciling)
+	s.SetStandardCondition(stalled)
+}
+
+// MigrateConditions adds the standard conditions to a status written before
+// they existed. It returns false, and does nothing, if the Ready condition
+// is already present.
+func (s *OpenstackLcmStatus) MigrateConditions(generation int64) bool {
+	if s.GetStandardCondition(ConditionReady) != nil {
+		return false
+	}
+	s.ComputeStandardConditions(generation)
+	return true
+}
@@ -46,6 +46,7 @@ type TestPhaseStatus struct {
 	TestResults TestResults `json:"testResults,omitempty"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TestPhase is the Schema for the openstackdeployments API
@@ -64,22 +65,6 @@ type TestPhase struct {
 	Status TestPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an TestPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *TestPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-
-	if obj.Status.TestResults == "" {
-		obj.Status.TestResults = TestResultsPassed
-	}
-}
-
 // Return the list of dependent resources to watch
 func (obj *TestPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
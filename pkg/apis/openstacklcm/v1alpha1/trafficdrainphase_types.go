@@ -27,6 +27,7 @@ type TrafficDrainPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TrafficDrainPhase is the Schema for the openstackdeployments API
@@ -44,18 +45,6 @@ type TrafficDrainPhase struct {
 	Status TrafficDrainPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an TrafficDrainPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *TrafficDrainPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *TrafficDrainPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -28,6 +28,7 @@ type TrafficRolloutPhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // TrafficRolloutPhase is the Schema for the openstackdeployments API
@@ -45,18 +46,6 @@ type TrafficRolloutPhase struct {
 	Status TrafficRolloutPhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an TrafficRolloutPhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *TrafficRolloutPhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *TrafficRolloutPhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -90,6 +90,7 @@ type UpgradePhaseStatus struct {
 	PhaseStatus `json:",inline"`
 }
 
+// +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // UpgradePhase is the Schema for the openstackdeployments API
@@ -107,18 +108,6 @@ type UpgradePhase struct {
 	Status UpgradePhaseStatus `json:"status,omitempty"`
 }
 
-// Init is used to initialize an UpgradePhase. Namely, if the state has not been
-// specified, it will be set
-func (obj *UpgradePhase) Init() {
-	if obj.Status.ActualState == "" {
-		obj.Status.ActualState = StateUninitialized
-	}
-	if obj.Spec.TargetState == "" {
-		obj.Spec.TargetState = StateDeployed
-	}
-	obj.Status.Succeeded = (obj.Spec.TargetState == obj.Status.ActualState)
-}
-
 // Return the list of dependent resources to watch
 func (obj *UpgradePhase) GetDependentResources() []unstructured.Unstructured {
 	var res = make([]unstructured.Unstructured, 0)
@@ -0,0 +1,146 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"fmt"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FormatVersion is the version of the archives written by this package
+const FormatVersion = 1
+
+// IndexPath is the path of the index, the first entry of an archive
+const IndexPath = "index.json"
+
+// HelmReleaseSecretType is the type of the Secrets in which helm 3 stores
+// the releases
+const HelmReleaseSecretType corev1.SecretType = "helm.sh/release.v1"
+
+// EntryKind is the kind of content of an entry
+type EntryKind string
+
+// Kinds of entries
+const (
+	// EntryChart holds the ArmadaChart
+	EntryChart EntryKind = "chart"
+	// EntryValues holds the effective values of the chart
+	EntryValues EntryKind = "values"
+	// EntryRevision holds a ControllerRevision of the chart spec
+	EntryRevision EntryKind = "revision"
+	// EntryRelease holds a helm release Secret
+	EntryRelease EntryKind = "release"
+)
+
+// Index describes the content of an archive
+type Index struct {
+	FormatVersion int `json:"formatVersion"`
+	Metadata
+	// Charts lists the names of the ArmadaCharts, in the archive order
+	Charts []string `json:"charts"`
+	// Entries lists every entry but the index, in the archive order
+	Entries []Entry `json:"entries"`
+}
+
+// Metadata identifies an archive
+type Metadata struct {
+	// Generator names the program, and its version, which wrote the archive
+	Generator string      `json:"generator,omitempty"`
+	Created   metav1.Time `json:"created"`
+	// Backup and Namespace identify the ArmadaBackup the archive was taken for
+	Backup    string `json:"backup,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Entry describes a file of the archive
+type Entry struct {
+	Path string    `json:"path"`
+	Kind EntryKind `json:"kind"`
+	// Chart is the name of the ArmadaChart the entry belongs to
+	Chart  string `json:"chart"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// ChartState is the state of an ArmadaChart and of its release
+type ChartState struct {
+	Chart *av1.ArmadaChart
+	// Values are the effective values the release was deployed with
+	Values map[string]interface{}
+	// Revisions are the history of the chart spec
+	Revisions []*appsv1.ControllerRevision
+	// Releases are the helm release Secrets, one per release version
+	Releases []*corev1.Secret
+	// SchemaErrors are set by the Reader for the fields of the stored
+	// objects which the current types do not know, or which are set twice
+	SchemaErrors []error
+}
+
+// VersionError is returned for an archive written with a newer format
+type VersionError struct {
+	Version int
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("unsupported archive format version %d, the latest supported is %d", e.Version, FormatVersion)
+}
+
+// ChecksumError is returned for an entry whose content does not match the index
+type ChecksumError struct {
+	Path     string
+	Expected string
+	Actual   string
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("entry %s: checksum mismatch, expected sha256 %s, got %s", e.Path, e.Expected, e.Actual)
+}
+
+// SchemaError reports a field of a stored object which does not decode
+// strictly into the current type
+type SchemaError struct {
+	Path string
+	Err  error
+}
+
+func (e *SchemaError) Error() string {
+	return fmt.Sprintf("entry %s: %v", e.Path, e.Err)
+}
+
+func (e *SchemaError) Unwrap() error {
+	return e.Err
+}
+
+// FormatError is returned for an archive which does not follow the format
+type FormatError struct {
+	Path   string
+	Reason string
+}
+
+func (e *FormatError) Error() string {
+	if e.Path == "" {
+		return "invalid archive: " + e.Reason
+	}
+	return fmt.Sprintf("invalid archive: entry %s: %s", e.Path, e.Reason)
+}
+
+// chartPath returns the path of an entry of a chart
+func chartPath(chart string, elem string) string {
+	return "charts/" + chart + "/" + elem
+}
@@ -0,0 +1,341 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var created = metav1.NewTime(time.Date(2019, 6, 2, 2, 0, 0, 0, time.UTC))
+
+func newState(name string) ChartState {
+	chart := &av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       "openstack",
+			UID:             "2d7a4b1c",
+			ResourceVersion: "4242",
+			Labels:          map[string]string{"application": "openstack"},
+		},
+		Spec: av1.ArmadaChartSpec{
+			ChartName:   name,
+			Release:     name,
+			Namespace:   "openstack",
+			TargetState: av1.StateDeployed,
+		},
+		Status: av1.ArmadaChartStatus{ArmadaStatus: av1.ArmadaStatus{ActualState: av1.StateDeployed, Satisfied: true}},
+	}
+	revision := func(number int64) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name + "-" + string(rune('a'+number)),
+				Namespace:       "openstack",
+				OwnerReferences: []metav1.OwnerReference{{Name: name, UID: "2d7a4b1c"}},
+			},
+			Data:     runtime.RawExtension{Raw: []byte(`{"chart_name":"` + name + `"}`)},
+			Revision: number,
+		}
+	}
+	release := func(version string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sh.helm.release.v1." + name + ".v" + version,
+				Namespace: "openstack",
+				Labels:    map[string]string{"owner": "helm", "name": name, "version": version},
+			},
+			Type: HelmReleaseSecretType,
+			Data: map[string][]byte{"release": []byte("H4sIAAAAAAAA/" + version)},
+		}
+	}
+	return ChartState{
+		Chart:     chart,
+		Values:    map[string]interface{}{"pod": map[string]interface{}{"replicas": map[string]interface{}{"api": int64(3)}}},
+		Revisions: []*appsv1.ControllerRevision{revision(2), revision(1)},
+		Releases:  []*corev1.Secret{release("1"), release("2")},
+	}
+}
+
+func write(t *testing.T, states ...ChartState) []byte {
+	var buf bytes.Buffer
+	w := NewWriter(&buf, Metadata{Generator: "armada-operator/test", Created: created, Backup: "nightly", Namespace: "openstack"})
+	for _, state := range states {
+		if err := w.Add(state); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// file is an entry of a tarball built by hand
+type file struct {
+	Entry
+	content string
+}
+
+func newFile(kind EntryKind, chart string, path string, content string) file {
+	sum := sha256.Sum256([]byte(content))
+	return file{
+		Entry:   Entry{Path: path, Kind: kind, Chart: chart, Size: int64(len(content)), SHA256: hex.EncodeToString(sum[:])},
+		content: content,
+	}
+}
+
+// tarball writes the index, then the files, without any check
+func tarball(t *testing.T, index *Index, files []file) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	data, err := json.Marshal(index)
+	if err != nil {
+		t.Fatal(err)
+	}
+	add := func(name string, content []byte) {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(content); err != nil {
+			t.Fatal(err)
+		}
+	}
+	add(IndexPath, data)
+	for _, f := range files {
+		add(f.Path, []byte(f.content))
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func indexOf(files []file) *Index {
+	index := &Index{FormatVersion: 1, Metadata: Metadata{Created: created}, Charts: []string{}}
+	for _, f := range files {
+		if f.Kind == EntryChart {
+			index.Charts = append(index.Charts, f.Chart)
+		}
+		index.Entries = append(index.Entries, f.Entry)
+	}
+	return index
+}
+
+// v1Files are the files of a format 1 archive, as written by the first
+// version of the writer. They must remain readable.
+func v1Files() []file {
+	return []file{
+		newFile(EntryChart, "keystone", "charts/keystone/chart.json",
+			`{"kind":"ArmadaChart","apiVersion":"armada.airshipit.org/v1alpha1","metadata":{"name":"keystone","namespace":"openstack"},`+
+				`"spec":{"chart_name":"keystone","release":"keystone","source":null,"dependencies":null,"target_state":"deployed"},`+
+				`"status":{"satisfied":false,"actual_state":""}}`),
+		newFile(EntryValues, "keystone", "charts/keystone/values.json", `{"pod":{"replicas":{"api":3}}}`),
+		newFile(EntryRevision, "keystone", "charts/keystone/revisions/1.json",
+			`{"kind":"ControllerRevision","apiVersion":"apps/v1","metadata":{"name":"keystone-b","namespace":"openstack"},"data":{"chart_name":"keystone"},"revision":1}`),
+		newFile(EntryRelease, "keystone", "charts/keystone/releases/sh.helm.release.v1.keystone.v1.json",
+			`{"kind":"Secret","apiVersion":"v1","metadata":{"name":"sh.helm.release.v1.keystone.v1","namespace":"openstack"},"data":{"release":"SDRzSUFBQUFBQUFBLzE="},"type":"helm.sh/release.v1"}`),
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	keystone, glance := newState("keystone"), newState("glance")
+	glance.Values = nil
+	glance.Releases = nil
+	data := write(t, keystone, glance)
+	g.Expect(write(t, keystone, glance)).To(gomega.Equal(data), "the archive of a state never changes")
+
+	index, states, err := Read(bytes.NewReader(data))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(index.FormatVersion).To(gomega.Equal(FormatVersion))
+	g.Expect(index.Generator).To(gomega.Equal("armada-operator/test"))
+	g.Expect(index.Created.Equal(&created)).To(gomega.BeTrue())
+	g.Expect(index.Backup).To(gomega.Equal("nightly"))
+	g.Expect(index.Namespace).To(gomega.Equal("openstack"))
+	g.Expect(index.Charts).To(gomega.Equal([]string{"keystone", "glance"}))
+	g.Expect(index.Entries).To(gomega.HaveLen(10))
+	g.Expect(index.Entries[2].Path).To(gomega.Equal("charts/keystone/revisions/1.json"))
+	g.Expect(index.Entries[4]).To(gomega.And(
+		gomega.HaveField("Path", "charts/keystone/releases/sh.helm.release.v1.keystone.v1.json"),
+		gomega.HaveField("Kind", EntryRelease),
+		gomega.HaveField("Chart", "keystone"),
+	))
+	g.Expect(states).To(gomega.HaveLen(2))
+
+	state := states[0]
+	g.Expect(state.Chart.APIVersion).To(gomega.Equal("armada.airshipit.org/v1alpha1"))
+	g.Expect(state.Chart.Kind).To(gomega.Equal("ArmadaChart"))
+	g.Expect(state.Chart.UID).To(gomega.BeEmpty())
+	g.Expect(state.Chart.ResourceVersion).To(gomega.BeEmpty())
+	g.Expect(state.Chart.Labels).To(gomega.Equal(keystone.Chart.Labels))
+	g.Expect(state.Chart.Spec).To(gomega.Equal(keystone.Chart.Spec))
+	g.Expect(state.Chart.Status).To(gomega.Equal(av1.ArmadaChartStatus{}))
+	g.Expect(state.Values).To(gomega.Equal(map[string]interface{}{"pod": map[string]interface{}{"replicas": map[string]interface{}{"api": float64(3)}}}))
+	g.Expect(state.Revisions).To(gomega.HaveLen(2))
+	g.Expect(state.Revisions[0].Revision).To(gomega.Equal(int64(1)))
+	g.Expect(state.Revisions[0].OwnerReferences).To(gomega.BeEmpty())
+	g.Expect(state.Revisions[0].Data.Raw).To(gomega.MatchJSON(`{"chart_name":"keystone"}`))
+	g.Expect(state.Releases).To(gomega.HaveLen(2))
+	g.Expect(state.Releases[1].Name).To(gomega.Equal("sh.helm.release.v1.keystone.v2"))
+	g.Expect(state.Releases[1].Data).To(gomega.Equal(keystone.Releases[1].Data))
+
+	g.Expect(states[1].Values).To(gomega.BeEmpty())
+	g.Expect(states[1].Releases).To(gomega.BeEmpty())
+
+	// The states added are not modified
+	g.Expect(keystone.Chart.UID).NotTo(gomega.BeEmpty())
+	g.Expect(keystone.Revisions[0].Revision).To(gomega.Equal(int64(2)))
+}
+
+func TestWriterErrors(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	w := NewWriter(io.Discard, Metadata{})
+
+	g.Expect(w.Add(ChartState{})).NotTo(gomega.Succeed())
+	g.Expect(w.Add(newState("keystone"))).To(gomega.Succeed())
+	g.Expect(w.Add(newState("keystone"))).NotTo(gomega.Succeed())
+
+	state := newState("glance")
+	state.Releases[0].Type = corev1.SecretTypeOpaque
+	g.Expect(w.Add(state)).NotTo(gomega.Succeed())
+
+	state = newState("glance")
+	state.Revisions[0].Revision = 1
+	g.Expect(w.Add(state)).NotTo(gomega.Succeed())
+
+	// Too large to be read back
+	state = newState("glance")
+	state.Values = map[string]interface{}{"blob": strings.Repeat("x", maxEntrySize)}
+	g.Expect(w.Add(state)).NotTo(gomega.Succeed())
+
+	g.Expect(w.Close()).To(gomega.Succeed())
+	g.Expect(w.Add(newState("glance"))).NotTo(gomega.Succeed())
+}
+
+func TestReadFormatVersion1(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	files := v1Files()
+	index, states, err := Read(bytes.NewReader(tarball(t, indexOf(files), files)))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(index.Charts).To(gomega.Equal([]string{"keystone"}))
+	g.Expect(states).To(gomega.HaveLen(1))
+	g.Expect(states[0].Chart.Spec.ChartName).To(gomega.Equal("keystone"))
+	g.Expect(states[0].Values).To(gomega.HaveKey("pod"))
+	g.Expect(states[0].Revisions[0].Name).To(gomega.Equal("keystone-b"))
+	g.Expect(states[0].Releases[0].Data["release"]).To(gomega.Equal([]byte("H4sIAAAAAAAA/1")))
+
+	g.Expect(states[0].SchemaErrors).To(gomega.BeEmpty())
+
+	// The fields unknown to the current types are reported
+	files[0] = newFile(EntryChart, "keystone", "charts/keystone/chart.json",
+		`{"kind":"ArmadaChart","apiVersion":"armada.airshipit.org/v1alpha1","metadata":{"name":"keystone"},"spec":{"chart_name":"keystone","retired":true}}`)
+	_, states, err = Read(bytes.NewReader(tarball(t, indexOf(files), files)))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(states[0].SchemaErrors).To(gomega.HaveLen(1))
+	g.Expect(states[0].SchemaErrors[0].Error()).To(gomega.ContainSubstring(`unknown field "spec.retired"`))
+
+	// The kinds of entries added later are verified and skipped
+	files = append(files, newFile("hooks", "keystone", "charts/keystone/hooks.json", `{}`))
+	_, states, err = Read(bytes.NewReader(tarball(t, indexOf(files), files)))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(states).To(gomega.HaveLen(1))
+}
+
+func TestReadErrors(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	read := func(index *Index, files []file) error {
+		_, _, err := Read(bytes.NewReader(tarball(t, index, files)))
+		return err
+	}
+	var formatErr *FormatError
+
+	// Newer format
+	files := v1Files()
+	index := indexOf(files)
+	index.FormatVersion = FormatVersion + 1
+	var versionErr *VersionError
+	g.Expect(errors.As(read(index, files), &versionErr)).To(gomega.BeTrue())
+
+	// Tampered entry
+	files = v1Files()
+	index = indexOf(files)
+	files[1].content = `{"pod":{"replicas":{"api":1}}}`
+	var checksumErr *ChecksumError
+	err := read(index, files)
+	g.Expect(errors.As(err, &checksumErr)).To(gomega.BeTrue())
+	g.Expect(checksumErr.Path).To(gomega.Equal("charts/keystone/values.json"))
+
+	// Missing entry
+	files = v1Files()
+	index = indexOf(files)
+	g.Expect(errors.As(read(index, files[:3]), &formatErr)).To(gomega.BeTrue())
+	g.Expect(formatErr.Path).To(gomega.Equal("charts/keystone/releases/sh.helm.release.v1.keystone.v1.json"))
+
+	// Entry missing from the index
+	files = v1Files()
+	index = indexOf(files[:3])
+	g.Expect(errors.As(read(index, files), &formatErr)).To(gomega.BeTrue())
+	g.Expect(formatErr.Reason).To(gomega.Equal("not in the index"))
+
+	// Entry too large to be read into memory
+	files = v1Files()
+	index = indexOf(files)
+	index.Entries[1].Size = maxEntrySize + 1
+	g.Expect(errors.As(read(index, files), &formatErr)).To(gomega.BeTrue())
+	g.Expect(formatErr.Path).To(gomega.Equal("charts/keystone/values.json"))
+
+	// Entries out of order
+	files = v1Files()
+	index = indexOf(files)
+	files[1], files[2] = files[2], files[1]
+	g.Expect(errors.As(read(index, files), &formatErr)).To(gomega.BeTrue())
+
+	// Entries of a chart before the chart
+	files = v1Files()
+	files[0], files[1] = files[1], files[0]
+	g.Expect(errors.As(read(indexOf(files), files), &formatErr)).To(gomega.BeTrue())
+
+	// Object of the wrong kind
+	files = v1Files()
+	files[2] = newFile(EntryRevision, "keystone", "charts/keystone/revisions/1.json", `{"kind":"Secret","apiVersion":"v1"}`)
+	g.Expect(errors.As(read(indexOf(files), files), &formatErr)).To(gomega.BeTrue())
+
+	// Not an archive
+	_, _, err = Read(bytes.NewReader([]byte("armada")))
+	g.Expect(errors.As(err, &formatErr)).To(gomega.BeTrue())
+}
@@ -0,0 +1,36 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package archive reads and writes the backups of the armada release
+// state. An archive is a gzip compressed tarball:
+//
+//	index.json
+//	charts/<chart>/chart.json
+//	charts/<chart>/values.json
+//	charts/<chart>/revisions/<revision>.json
+//	charts/<chart>/releases/<secret>.json
+//
+// The index comes first. It records the format version and, in the order
+// of the tarball, the path, kind, size and SHA-256 of every entry, so that
+// an archive is verified while it is read. The entries of a chart follow
+// each other, its ArmadaChart first: the chart itself, its effective values,
+// the ControllerRevisions of its spec history and the helm release Secrets
+// of its release.
+//
+// The objects are stored as JSON with their apiVersion and kind, without
+// the fields the API server sets. FormatVersion only changes when the
+// layout does; a reader accepts the archives of its version and of the
+// previous ones, and verifies then skips the kinds of entries it does not
+// know, so that new kinds can be added to a version.
+package archive
@@ -0,0 +1,258 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kjson "sigs.k8s.io/json"
+)
+
+// maxIndexSize bounds the size of the index read into memory
+const maxIndexSize = 16 << 20
+
+// maxEntrySize bounds the size of an entry read into memory, well above
+// the size of the objects the API server stores
+const maxEntrySize = 16 << 20
+
+// Reader reads an archive chart by chart, verifying each entry against
+// the index as it goes.
+type Reader struct {
+	tr    *tar.Reader
+	index Index
+	next  int
+	done  bool
+}
+
+// NewReader reads the index of the archive read from r. It fails with a
+// *VersionError for an archive of a newer format.
+func NewReader(r io.Reader) (*Reader, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, &FormatError{Reason: "not a gzip compressed tarball: " + err.Error()}
+	}
+	tr := tar.NewReader(gz)
+	hdr, err := tr.Next()
+	if err != nil {
+		return nil, &FormatError{Reason: "no index: " + err.Error()}
+	}
+	if hdr.Name != IndexPath {
+		return nil, &FormatError{Path: hdr.Name, Reason: "the archive must start with " + IndexPath}
+	}
+	if hdr.Size > maxIndexSize {
+		return nil, &FormatError{Path: IndexPath, Reason: fmt.Sprintf("larger than %d bytes", maxIndexSize)}
+	}
+	data, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, &FormatError{Path: IndexPath, Reason: err.Error()}
+	}
+
+	res := &Reader{tr: tr}
+	if err := json.Unmarshal(data, &res.index); err != nil {
+		return nil, &FormatError{Path: IndexPath, Reason: err.Error()}
+	}
+	if res.index.FormatVersion < 1 {
+		return nil, &FormatError{Path: IndexPath, Reason: fmt.Sprintf("invalid format version %d", res.index.FormatVersion)}
+	}
+	if res.index.FormatVersion > FormatVersion {
+		return nil, &VersionError{Version: res.index.FormatVersion}
+	}
+	if err := validateIndex(&res.index); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Index returns the index of the archive
+func (r *Reader) Index() *Index {
+	return &r.index
+}
+
+// Next returns the state of the next chart, in the order of Index.Charts,
+// and io.EOF after the last one.
+func (r *Reader) Next() (*ChartState, error) {
+	entries := r.index.Entries
+	if r.next >= len(entries) {
+		if !r.done {
+			r.done = true
+			if hdr, err := r.tr.Next(); err == nil {
+				return nil, &FormatError{Path: hdr.Name, Reason: "not in the index"}
+			} else if err != io.EOF {
+				return nil, &FormatError{Reason: err.Error()}
+			}
+		}
+		return nil, io.EOF
+	}
+
+	chart := entries[r.next].Chart
+	state := &ChartState{
+		Revisions: make([]*appsv1.ControllerRevision, 0),
+		Releases:  make([]*corev1.Secret, 0),
+	}
+	for ; r.next < len(entries) && entries[r.next].Chart == chart; r.next++ {
+		entry := entries[r.next]
+		data, err := r.read(entry)
+		if err != nil {
+			return nil, err
+		}
+		switch entry.Kind {
+		case EntryChart:
+			state.Chart = &av1.ArmadaChart{}
+			err = decode(state, entry, data, state.Chart, av1.SchemeGroupVersion.WithKind("ArmadaChart"))
+		case EntryValues:
+			err = json.Unmarshal(data, &state.Values)
+		case EntryRevision:
+			rev := &appsv1.ControllerRevision{}
+			err = decode(state, entry, data, rev, appsv1.SchemeGroupVersion.WithKind("ControllerRevision"))
+			state.Revisions = append(state.Revisions, rev)
+		case EntryRelease:
+			secret := &corev1.Secret{}
+			err = decode(state, entry, data, secret, corev1.SchemeGroupVersion.WithKind("Secret"))
+			state.Releases = append(state.Releases, secret)
+		default:
+			// Written by a newer version: verified, but not understood
+		}
+		if err != nil {
+			return nil, &FormatError{Path: entry.Path, Reason: err.Error()}
+		}
+	}
+	if state.Values == nil {
+		state.Values = make(map[string]interface{})
+	}
+	return state, nil
+}
+
+// read returns the content of the next entry of the tarball, which must
+// be the given entry of the index
+func (r *Reader) read(entry Entry) ([]byte, error) {
+	hdr, err := r.tr.Next()
+	if err == io.EOF {
+		return nil, &FormatError{Path: entry.Path, Reason: "missing"}
+	}
+	if err != nil {
+		return nil, &FormatError{Path: entry.Path, Reason: err.Error()}
+	}
+	if hdr.Name != entry.Path {
+		return nil, &FormatError{Path: hdr.Name, Reason: "found in place of " + entry.Path}
+	}
+	if hdr.Size != entry.Size {
+		return nil, &FormatError{Path: entry.Path, Reason: fmt.Sprintf("size %d, expected %d", hdr.Size, entry.Size)}
+	}
+	// The size was checked by validateIndex
+	var data bytes.Buffer
+	data.Grow(int(entry.Size))
+	hash := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(&data, hash), io.LimitReader(r.tr, entry.Size)); err != nil {
+		return nil, &FormatError{Path: entry.Path, Reason: err.Error()}
+	}
+	if actual := hex.EncodeToString(hash.Sum(nil)); actual != entry.SHA256 {
+		return nil, &ChecksumError{Path: entry.Path, Expected: entry.SHA256, Actual: actual}
+	}
+	return data.Bytes(), nil
+}
+
+// Read reads a whole archive
+func Read(r io.Reader) (*Index, []ChartState, error) {
+	reader, err := NewReader(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	states := make([]ChartState, 0, len(reader.index.Charts))
+	for {
+		state, err := reader.Next()
+		if err == io.EOF {
+			return reader.Index(), states, nil
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		states = append(states, *state)
+	}
+}
+
+// decode unmarshals an object, which must be of the given kind. The fields
+// which do not decode strictly are added to the SchemaErrors of the state.
+func decode(state *ChartState, entry Entry, data []byte, obj interface{}, gvk schema.GroupVersionKind) error {
+	var meta struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+	}
+	if err := json.Unmarshal(data, &meta); err != nil {
+		return err
+	}
+	if meta.APIVersion != gvk.GroupVersion().String() || meta.Kind != gvk.Kind {
+		return fmt.Errorf("%s entry holds a %s %s, expected %s %s", entry.Kind, meta.APIVersion, meta.Kind, gvk.GroupVersion(), gvk.Kind)
+	}
+	strictErrs, err := kjson.UnmarshalStrict(data, obj, kjson.DisallowUnknownFields, kjson.DisallowDuplicateFields)
+	if err != nil {
+		return err
+	}
+	for _, strictErr := range strictErrs {
+		state.SchemaErrors = append(state.SchemaErrors, &SchemaError{Path: entry.Path, Err: strictErr})
+	}
+	return nil
+}
+
+// validateIndex checks the sizes of the entries, and that the entries of
+// each chart follow each other, its ArmadaChart first, in the order of
+// Charts
+func validateIndex(index *Index) error {
+	paths := make(map[string]bool)
+	seen := make(map[string]bool)
+	charts := make([]string, 0, len(index.Charts))
+	for i, entry := range index.Entries {
+		if entry.Path == "" || entry.Path == IndexPath || paths[entry.Path] {
+			return &FormatError{Path: IndexPath, Reason: fmt.Sprintf("invalid or duplicate path %q", entry.Path)}
+		}
+		paths[entry.Path] = true
+		if entry.Size < 0 || entry.Size > maxEntrySize {
+			return &FormatError{Path: entry.Path, Reason: fmt.Sprintf("size %d, larger than %d bytes", entry.Size, maxEntrySize)}
+		}
+		if i > 0 && index.Entries[i-1].Chart == entry.Chart {
+			if entry.Kind == EntryChart {
+				return &FormatError{Path: IndexPath, Reason: "ArmadaChart " + entry.Chart + " has several chart entries"}
+			}
+			continue
+		}
+		if entry.Kind != EntryChart {
+			return &FormatError{Path: IndexPath, Reason: "the entries of ArmadaChart " + entry.Chart + " must start with its chart entry"}
+		}
+		if seen[entry.Chart] {
+			return &FormatError{Path: IndexPath, Reason: "the entries of ArmadaChart " + entry.Chart + " must follow each other"}
+		}
+		seen[entry.Chart] = true
+		charts = append(charts, entry.Chart)
+	}
+	if len(charts) != len(index.Charts) {
+		return &FormatError{Path: IndexPath, Reason: "the charts do not match the entries"}
+	}
+	for i := range charts {
+		if charts[i] != index.Charts[i] {
+			return &FormatError{Path: IndexPath, Reason: "the charts do not match the entries"}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package archive
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/revision"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Writer writes an archive. The entries are kept in memory until Close,
+// since the index which comes first holds their checksums.
+type Writer struct {
+	w       io.Writer
+	index   Index
+	content [][]byte
+	closed  bool
+}
+
+// NewWriter returns a Writer writing the archive to w
+func NewWriter(w io.Writer, metadata Metadata) *Writer {
+	return &Writer{
+		w: w,
+		index: Index{
+			FormatVersion: FormatVersion,
+			Metadata:      metadata,
+			Charts:        make([]string, 0),
+			Entries:       make([]Entry, 0),
+		},
+		content: make([][]byte, 0),
+	}
+}
+
+// Add adds the state of a chart to the archive. The objects are copied
+// and stripped of the fields set by the API server, the status of the
+// chart and the owner references included. The revisions are sorted from
+// the oldest to the newest; the release Secrets keep their order.
+func (w *Writer) Add(state ChartState) error {
+	if w.closed {
+		return fmt.Errorf("archive already closed")
+	}
+	if state.Chart == nil || state.Chart.Name == "" {
+		return fmt.Errorf("the ArmadaChart of the state is required")
+	}
+	name := state.Chart.Name
+	for _, chart := range w.index.Charts {
+		if chart == name {
+			return fmt.Errorf("ArmadaChart %s already added", name)
+		}
+	}
+
+	entries := make([]Entry, 0)
+	content := make([][]byte, 0)
+	add := func(kind EntryKind, path string, obj interface{}) error {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return fmt.Errorf("ArmadaChart %s: %s: %v", name, path, err)
+		}
+		if len(data) > maxEntrySize {
+			return fmt.Errorf("ArmadaChart %s: %s: larger than %d bytes", name, path, maxEntrySize)
+		}
+		sum := sha256.Sum256(data)
+		entries = append(entries, Entry{
+			Path:   path,
+			Kind:   kind,
+			Chart:  name,
+			Size:   int64(len(data)),
+			SHA256: hex.EncodeToString(sum[:]),
+		})
+		content = append(content, data)
+		return nil
+	}
+
+	chart := state.Chart.DeepCopy()
+	chart.TypeMeta = metav1.TypeMeta{APIVersion: av1.SchemeGroupVersion.String(), Kind: "ArmadaChart"}
+	clean(&chart.ObjectMeta)
+	chart.Status = av1.ArmadaChartStatus{}
+	if err := add(EntryChart, chartPath(name, "chart.json"), chart); err != nil {
+		return err
+	}
+
+	values := state.Values
+	if values == nil {
+		values = make(map[string]interface{})
+	}
+	if err := add(EntryValues, chartPath(name, "values.json"), values); err != nil {
+		return err
+	}
+
+	revisions := make([]*appsv1.ControllerRevision, 0, len(state.Revisions))
+	for _, rev := range state.Revisions {
+		rev = rev.DeepCopy()
+		rev.TypeMeta = metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "ControllerRevision"}
+		clean(&rev.ObjectMeta)
+		revisions = append(revisions, rev)
+	}
+	revision.Sort(revisions)
+	for i, rev := range revisions {
+		if i > 0 && revisions[i-1].Revision == rev.Revision {
+			return fmt.Errorf("ArmadaChart %s: duplicate revision %d", name, rev.Revision)
+		}
+		if err := add(EntryRevision, chartPath(name, "revisions/"+strconv.FormatInt(rev.Revision, 10)+".json"), rev); err != nil {
+			return err
+		}
+	}
+
+	seen := make(map[string]bool)
+	for _, secret := range state.Releases {
+		if secret.Type != HelmReleaseSecretType {
+			return fmt.Errorf("ArmadaChart %s: Secret %s is of type %q, not %q", name, secret.Name, secret.Type, HelmReleaseSecretType)
+		}
+		if seen[secret.Name] {
+			return fmt.Errorf("ArmadaChart %s: duplicate Secret %s", name, secret.Name)
+		}
+		seen[secret.Name] = true
+		secret = secret.DeepCopy()
+		secret.TypeMeta = metav1.TypeMeta{APIVersion: corev1.SchemeGroupVersion.String(), Kind: "Secret"}
+		clean(&secret.ObjectMeta)
+		if err := add(EntryRelease, chartPath(name, "releases/"+secret.Name+".json"), secret); err != nil {
+			return err
+		}
+	}
+
+	w.index.Charts = append(w.index.Charts, name)
+	w.index.Entries = append(w.index.Entries, entries...)
+	w.content = append(w.content, content...)
+	return nil
+}
+
+// Close writes the archive. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	index, err := json.Marshal(&w.index)
+	if err != nil {
+		return err
+	}
+	gz := gzip.NewWriter(w.w)
+	tw := tar.NewWriter(gz)
+	modTime := w.index.Created.Time
+	if modTime.IsZero() {
+		modTime = time.Unix(0, 0)
+	}
+	write := func(path string, data []byte) error {
+		hdr := &tar.Header{
+			Typeflag: tar.TypeReg,
+			Name:     path,
+			Mode:     0644,
+			Size:     int64(len(data)),
+			ModTime:  modTime,
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	if err := write(IndexPath, index); err != nil {
+		return err
+	}
+	for i, entry := range w.index.Entries {
+		if err := write(entry.Path, w.content[i]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// clean removes the fields set by the API server. The owner references
+// are removed as well: their uids are those of the backed up objects.
+func clean(meta *metav1.ObjectMeta) {
+	meta.UID = ""
+	meta.ResourceVersion = ""
+	meta.Generation = 0
+	meta.CreationTimestamp = metav1.Time{}
+	meta.DeletionTimestamp = nil
+	meta.DeletionGracePeriodSeconds = nil
+	meta.OwnerReferences = nil
+	meta.ManagedFields = nil
+}
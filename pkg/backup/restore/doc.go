@@ -0,0 +1,21 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package restore plans the restore of an archive of package archive. A
+// dry run opens the backup named by the RestoreSource of an ArmadaRestore,
+// verifies the checksums of the archive, checks that the stored objects
+// still decode strictly into the current types, from which the CRD schemas
+// are generated, and validate as the webhook validates them, then lists
+// the charts and releases the restore would create, replace or leave alone.
+package restore
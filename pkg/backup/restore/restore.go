@@ -0,0 +1,200 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/archive"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"github.com/keleustes/armada-crd/pkg/webhook"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// Labels set by helm on its release Secrets
+const (
+	// HelmOwnerSelector selects the release Secrets of helm
+	HelmOwnerSelector = "owner=helm"
+	helmNameLabel     = "name"
+	helmVersionLabel  = "version"
+	helmReleaseKey    = "release"
+)
+
+// Live is the state of the cluster a backup is compared with
+type Live struct {
+	// Charts are the ArmadaCharts of the namespace of the restore
+	Charts []av1.ArmadaChart
+	// Releases are the helm release Secrets of the release namespaces
+	Releases []corev1.Secret
+}
+
+// Open returns the archive of the backup named by the RestoreSource
+func Open(ctx context.Context, spec *av1.ArmadaRestoreSpec, creds storage.Credentials) (io.ReadCloser, error) {
+	backend, key, err := storage.ForRestore(spec, creds)
+	if err != nil {
+		return nil, err
+	}
+	return backend.Get(ctx, key)
+}
+
+// DryRun reads the archive from r and plans its restore. An archive which
+// can not be read yields a plan with its error and no action.
+func DryRun(r io.Reader, restore *av1.ArmadaRestore, live Live) *av1.RestorePlan {
+	index, states, err := archive.Read(r)
+	if err != nil {
+		return &av1.RestorePlan{Errors: []string{err.Error()}}
+	}
+	return Plan(restore, index, states, live)
+}
+
+// Plan computes the actions of a restore. The charts are restored in the
+// namespace of the ArmadaRestore; those missing from spec.charts, when it
+// is set, are left alone. A chart is replaced when its spec differs from
+// the backup, a release when its latest version does.
+func Plan(restore *av1.ArmadaRestore, index *archive.Index, states []archive.ChartState, live Live) *av1.RestorePlan {
+	created := index.Created
+	plan := &av1.RestorePlan{
+		Backup:        index.Backup,
+		Created:       &created,
+		FormatVersion: index.FormatVersion,
+		Charts:        make([]av1.RestorePlanItem, 0, len(states)),
+		Releases:      make([]av1.RestorePlanItem, 0),
+	}
+	addError := func(format string, args ...interface{}) {
+		plan.Errors = append(plan.Errors, fmt.Sprintf(format, args...))
+	}
+
+	liveCharts := make(map[string]*av1.ArmadaChart)
+	for i := range live.Charts {
+		if live.Charts[i].Namespace == restore.Namespace {
+			liveCharts[live.Charts[i].Name] = &live.Charts[i]
+		}
+	}
+	liveSecrets := make([]*corev1.Secret, 0, len(live.Releases))
+	for i := range live.Releases {
+		liveSecrets = append(liveSecrets, &live.Releases[i])
+	}
+	liveReleases, errs := latest(liveSecrets)
+	for _, err := range errs {
+		addError("%v", err)
+	}
+	selected := sets.New(restore.Spec.Charts...)
+	backedUp := sets.New[string]()
+
+	for _, state := range states {
+		name := state.Chart.Name
+		backedUp.Insert(name)
+		for _, err := range state.SchemaErrors {
+			addError("ArmadaChart %s: %v", name, err)
+		}
+		for _, err := range webhook.ValidateArmadaChart(state.Chart) {
+			addError("ArmadaChart %s: %v", name, err)
+		}
+
+		restored := selected.Len() == 0 || selected.Has(name)
+		item := av1.RestorePlanItem{Name: name, Namespace: restore.Namespace}
+		liveChart, found := liveCharts[name]
+		switch {
+		case !restored:
+			item.Action, item.Reason = av1.RestoreActionLeave, "not selected"
+		case !found:
+			item.Action, item.Reason = av1.RestoreActionCreate, "missing from the cluster"
+		case liveChart.Equivalent(state.Chart):
+			item.Action, item.Reason = av1.RestoreActionLeave, "same spec as the backup"
+		default:
+			item.Action, item.Reason = av1.RestoreActionReplace, "spec differs from the backup"
+		}
+		plan.Charts = append(plan.Charts, item)
+
+		releases, errs := planReleases(state.Releases, liveReleases, restored)
+		for _, err := range errs {
+			addError("ArmadaChart %s: %v", name, err)
+		}
+		plan.Releases = append(plan.Releases, releases...)
+	}
+
+	for _, name := range sets.List(selected.Difference(backedUp)) {
+		addError("ArmadaChart %s is not in the backup", name)
+	}
+	return plan
+}
+
+// release is the latest version of a helm release
+type release struct {
+	namespace string
+	name      string
+	version   int
+	secret    *corev1.Secret
+}
+
+// planReleases compares the latest version of each release of the backup
+// with the one of the cluster. The Secrets which are not helm releases are
+// skipped and reported.
+func planReleases(backup []*corev1.Secret, liveReleases map[string]*release, restored bool) ([]av1.RestorePlanItem, []error) {
+	backupReleases, errs := latest(backup)
+
+	keys := make([]string, 0, len(backupReleases))
+	for key := range backupReleases {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	items := make([]av1.RestorePlanItem, 0, len(keys))
+	for _, key := range keys {
+		rel := backupReleases[key]
+		item := av1.RestorePlanItem{Name: rel.name, Namespace: rel.namespace}
+		liveRel, found := liveReleases[key]
+		switch {
+		case !restored:
+			item.Action, item.Reason = av1.RestoreActionLeave, "not selected"
+		case !found:
+			item.Action, item.Reason = av1.RestoreActionCreate, fmt.Sprintf("missing from the cluster, version %d in the backup", rel.version)
+		case liveRel.version == rel.version && bytes.Equal(liveRel.secret.Data[helmReleaseKey], rel.secret.Data[helmReleaseKey]):
+			item.Action, item.Reason = av1.RestoreActionLeave, fmt.Sprintf("version %d, as in the backup", rel.version)
+		default:
+			item.Action, item.Reason = av1.RestoreActionReplace, fmt.Sprintf("version %d in the cluster, %d in the backup", liveRel.version, rel.version)
+		}
+		items = append(items, item)
+	}
+	return items, errs
+}
+
+// latest returns the latest version of each release, by namespace/name,
+// and an error for each Secret without the labels of a helm release, which
+// is skipped
+func latest(secrets []*corev1.Secret) (map[string]*release, []error) {
+	res := make(map[string]*release)
+	errs := make([]error, 0)
+	for _, secret := range secrets {
+		name := secret.Labels[helmNameLabel]
+		version, err := strconv.Atoi(secret.Labels[helmVersionLabel])
+		if name == "" || err != nil {
+			errs = append(errs, fmt.Errorf("Secret %s/%s lacks the %s and %s labels of a helm release", secret.Namespace, secret.Name, helmNameLabel, helmVersionLabel))
+			continue
+		}
+		key := secret.Namespace + "/" + name
+		if current, ok := res[key]; !ok || version > current.version {
+			res[key] = &release{namespace: secret.Namespace, name: name, version: version, secret: secret}
+		}
+	}
+	return res, errs
+}
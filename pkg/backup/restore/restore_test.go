@@ -0,0 +1,195 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restore
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/archive"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newChart(name string, replicas int) *av1.ArmadaChart {
+	return &av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "openstack"},
+		Spec: av1.ArmadaChartSpec{
+			ChartName:   name,
+			Release:     name,
+			Namespace:   "openstack",
+			Source:      &av1.ArmadaChartSource{Type: "local", Location: "/opt/openstack-helm/" + name},
+			TargetState: av1.StateDeployed,
+			Timeout:     replicas,
+		},
+	}
+}
+
+func newRelease(name string, version string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "sh.helm.release.v1." + name + ".v" + version,
+			Namespace: "openstack",
+			Labels:    map[string]string{"owner": "helm", "name": name, "version": version},
+		},
+		Type: archive.HelmReleaseSecretType,
+		Data: map[string][]byte{"release": []byte(name + version)},
+	}
+}
+
+func newArchive(t *testing.T, states ...archive.ChartState) []byte {
+	var buf bytes.Buffer
+	w := archive.NewWriter(&buf, archive.Metadata{
+		Created: metav1.NewTime(time.Date(2019, 6, 2, 2, 0, 0, 0, time.UTC)),
+		Backup:  "nightly-25990680",
+	})
+	for _, state := range states {
+		if err := w.Add(state); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func newRestore(charts ...string) *av1.ArmadaRestore {
+	return &av1.ArmadaRestore{
+		ObjectMeta: metav1.ObjectMeta{Name: "restore", Namespace: "openstack"},
+		Spec:       av1.ArmadaRestoreSpec{Charts: charts, DryRun: true},
+	}
+}
+
+func backupStates() []archive.ChartState {
+	return []archive.ChartState{
+		{Chart: newChart("keystone", 3), Releases: []*corev1.Secret{newRelease("keystone", "1"), newRelease("keystone", "2")}},
+		{Chart: newChart("glance", 2), Releases: []*corev1.Secret{newRelease("glance", "4")}},
+		{Chart: newChart("horizon", 1), Releases: []*corev1.Secret{newRelease("horizon", "1")}},
+	}
+}
+
+func liveState() Live {
+	return Live{
+		Charts: []av1.ArmadaChart{*newChart("keystone", 1), *newChart("horizon", 1), *newChart("nova", 1)},
+		Releases: []corev1.Secret{
+			*newRelease("keystone", "1"),
+			*newRelease("horizon", "1"),
+			*newRelease("nova", "7"),
+		},
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	plan := DryRun(bytes.NewReader(newArchive(t, backupStates()...)), newRestore(), liveState())
+	g.Expect(plan.Errors).To(gomega.BeEmpty())
+	g.Expect(plan.Backup).To(gomega.Equal("nightly-25990680"))
+	g.Expect(plan.FormatVersion).To(gomega.Equal(archive.FormatVersion))
+	g.Expect(plan.Charts).To(gomega.Equal([]av1.RestorePlanItem{
+		{Name: "keystone", Namespace: "openstack", Action: av1.RestoreActionReplace, Reason: "spec differs from the backup"},
+		{Name: "glance", Namespace: "openstack", Action: av1.RestoreActionCreate, Reason: "missing from the cluster"},
+		{Name: "horizon", Namespace: "openstack", Action: av1.RestoreActionLeave, Reason: "same spec as the backup"},
+	}))
+	g.Expect(plan.Releases).To(gomega.Equal([]av1.RestorePlanItem{
+		{Name: "keystone", Namespace: "openstack", Action: av1.RestoreActionReplace, Reason: "version 1 in the cluster, 2 in the backup"},
+		{Name: "glance", Namespace: "openstack", Action: av1.RestoreActionCreate, Reason: "missing from the cluster, version 4 in the backup"},
+		{Name: "horizon", Namespace: "openstack", Action: av1.RestoreActionLeave, Reason: "version 1, as in the backup"},
+	}))
+
+	// Same version, different content
+	live := liveState()
+	live.Releases[1].Data["release"] = []byte("rolled back by hand")
+	plan = DryRun(bytes.NewReader(newArchive(t, backupStates()...)), newRestore(), live)
+	g.Expect(plan.Releases[2].Action).To(gomega.Equal(av1.RestoreActionReplace))
+}
+
+func TestDryRunSelectedCharts(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	plan := DryRun(bytes.NewReader(newArchive(t, backupStates()...)), newRestore("glance", "nova"), liveState())
+	g.Expect(plan.Errors).To(gomega.Equal([]string{"ArmadaChart nova is not in the backup"}))
+	g.Expect(plan.Charts[0].Action).To(gomega.Equal(av1.RestoreActionLeave))
+	g.Expect(plan.Charts[0].Reason).To(gomega.Equal("not selected"))
+	g.Expect(plan.Charts[1].Action).To(gomega.Equal(av1.RestoreActionCreate))
+	g.Expect(plan.Releases[0].Action).To(gomega.Equal(av1.RestoreActionLeave))
+	g.Expect(plan.Releases[1].Action).To(gomega.Equal(av1.RestoreActionCreate))
+}
+
+func TestDryRunErrors(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// The archive does not verify
+	data := newArchive(t, backupStates()...)
+	data[len(data)/2] ^= 0xff
+	plan := DryRun(bytes.NewReader(data), newRestore(), liveState())
+	g.Expect(plan.Errors).To(gomega.HaveLen(1))
+	g.Expect(plan.Charts).To(gomega.BeEmpty())
+
+	// A stored chart does not validate
+	states := backupStates()
+	states[1].Chart.Spec.Release = ""
+	plan = DryRun(bytes.NewReader(newArchive(t, states...)), newRestore(), liveState())
+	g.Expect(plan.Errors).To(gomega.HaveLen(1))
+	g.Expect(plan.Errors[0]).To(gomega.ContainSubstring("ArmadaChart glance: spec.release"))
+	g.Expect(plan.Charts).To(gomega.HaveLen(3))
+
+	// A release Secret without the helm labels
+	states = backupStates()
+	delete(states[2].Releases[0].Labels, "version")
+	plan = DryRun(bytes.NewReader(newArchive(t, states...)), newRestore(), liveState())
+	g.Expect(plan.Errors).To(gomega.HaveLen(1))
+	g.Expect(plan.Errors[0]).To(gomega.ContainSubstring("ArmadaChart horizon: Secret openstack/sh.helm.release.v1.horizon.v1"))
+	g.Expect(plan.Releases).To(gomega.HaveLen(2))
+
+	// A live release Secret without the helm labels: the other live
+	// releases are still compared
+	live := liveState()
+	delete(live.Releases[2].Labels, "name")
+	plan = DryRun(bytes.NewReader(newArchive(t, backupStates()...)), newRestore(), live)
+	g.Expect(plan.Errors).To(gomega.Equal([]string{
+		"Secret openstack/sh.helm.release.v1.nova.v7 lacks the name and version labels of a helm release",
+	}))
+	g.Expect(plan.Releases[0].Action).To(gomega.Equal(av1.RestoreActionReplace))
+	g.Expect(plan.Releases[2].Action).To(gomega.Equal(av1.RestoreActionLeave))
+}
+
+func TestOpen(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "armada.backup")
+	g.Expect(os.WriteFile(path, newArchive(t, backupStates()...), 0644)).To(gomega.Succeed())
+
+	restore := newRestore()
+	restore.Spec.BackupStorageType = av1.BackupStorageTypeLocal
+	restore.Spec.Local = &av1.LocalRestoreSource{Path: path}
+	r, err := Open(t.Context(), &restore.Spec, storage.Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	defer r.Close()
+	plan := DryRun(r, restore, Live{})
+	g.Expect(plan.Errors).To(gomega.BeEmpty())
+	g.Expect(plan.Charts).To(gomega.HaveLen(3))
+
+	restore.Spec.Local.Path = path + ".missing"
+	_, err = Open(t.Context(), &restore.Spec, storage.Credentials{})
+	g.Expect(err).To(gomega.BeAssignableToTypeOf(&storage.NotFoundError{}))
+}
@@ -0,0 +1,20 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package schedule computes the runs of an ArmadaBackupSchedule and the
+// backups it expires. The schedules are parsed as the Kubernetes CronJob
+// ones are; each run stores its archive under its own path. The retention
+// keeps the newest finished backups of a schedule, by count and by age, and
+// deletes the archives of the expired ones.
+package schedule
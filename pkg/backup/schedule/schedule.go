@@ -0,0 +1,242 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/api/validate/content"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ParseError is returned for a schedule which is not a valid Cron expression
+type ParseError struct {
+	Schedule string
+	Err      error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid schedule %q: %v", e.Schedule, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses a schedule in the standard Cron format, with the "@daily"
+// like descriptors and an optional "CRON_TZ=<time zone>" prefix.
+func Parse(schedule string) (cron.Schedule, error) {
+	sched, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return nil, &ParseError{Schedule: schedule, Err: err}
+	}
+	return sched, nil
+}
+
+// MaxMissedRuns bounds the missed scheduled times NextRun walks through
+// one by one, as the CronJob controller bounds the missed start times of
+// a CronJob.
+const MaxMissedRuns = 100
+
+// Run tells when the backups of a schedule are taken
+type Run struct {
+	// Due is the latest scheduled time which has not been run yet. It is
+	// zero when no backup is due.
+	Due time.Time
+	// Next is the first scheduled time after now. It is zero while the
+	// schedule is suspended.
+	Next time.Time
+	// TooManyMissed is set when more than MaxMissedRuns scheduled times
+	// were missed, after a long outage or a clock skew for instance. Due is
+	// still the latest one; the caller may report it with an event, as the
+	// CronJob controller does.
+	TooManyMissed bool
+}
+
+// NextRun computes the runs of a schedule at now. The scheduled times are
+// counted from the last run, or from the creation of the schedule. Only the
+// latest of the missed times is due: one backup covers them all. Nothing is
+// due while the schedule is suspended. Past MaxMissedRuns missed times, the
+// latest one is searched for backwards from now instead.
+func NextRun(schedule *av1.ArmadaBackupSchedule, now time.Time) (Run, error) {
+	sched, err := Parse(schedule.Spec.Schedule)
+	if err != nil {
+		return Run{}, err
+	}
+
+	run := Run{}
+	if schedule.Spec.Suspend {
+		return run, nil
+	}
+	since := schedule.CreationTimestamp.Time
+	if schedule.Status.LastScheduleTime != nil {
+		since = schedule.Status.LastScheduleTime.Time
+	}
+	// Next returns the zero time for a schedule which never fires
+	var prev time.Time
+	missed := 0
+	for t := sched.Next(since); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+		prev, run.Due = run.Due, t
+		missed++
+		if missed > MaxMissedRuns {
+			run.TooManyMissed = true
+			run.Due = latestRun(sched, run.Due, run.Due.Sub(prev), now)
+			break
+		}
+	}
+	run.Next = sched.Next(now)
+	return run, nil
+}
+
+// latestRun returns the latest scheduled time after last and not after
+// now, or last if there is none. It walks from one window before now,
+// starting with twice the interval of the last two runs and doubling it
+// until the window holds a scheduled time or reaches last.
+func latestRun(sched cron.Schedule, last time.Time, interval time.Duration, now time.Time) time.Time {
+	window := 2 * interval
+	for {
+		start := now.Add(-window)
+		if !start.After(last) {
+			start = last
+		}
+		res := last
+		for t := sched.Next(start); !t.IsZero() && !t.After(now); t = sched.Next(t) {
+			res = t
+		}
+		if res != last || start == last {
+			return res
+		}
+		window *= 2
+	}
+}
+
+// MaxNameLength is the longest name of a schedule, 52 characters as for a
+// CronJob: the name is the value of the BackupScheduleLabel of its backups,
+// and leaves room for the "-<minutes>" suffix of their names.
+const MaxNameLength = content.LabelValueMaxLength - 11
+
+// runTimeFormat is the layout of the scheduled time in the backup paths
+const runTimeFormat = "20060102T150405Z"
+
+// NewBackup returns the ArmadaBackup of the run of a schedule at the
+// scheduled time. Its name is derived from the time, as the CronJob
+// controller names its Jobs, so a run is never taken twice. So is the path
+// of its archive, see RunPath, so a run never overwrites the archive of a
+// previous one.
+func NewBackup(schedule *av1.ArmadaBackupSchedule, scheduled time.Time) *av1.ArmadaBackup {
+	labels := make(map[string]string)
+	for k, v := range schedule.Labels {
+		labels[k] = v
+	}
+	labels[av1.BackupScheduleLabel] = schedule.Name
+
+	backup := &av1.ArmadaBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%d", schedule.Name, scheduled.Unix()/60),
+			Namespace: schedule.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				av1.BackupScheduledAtAnnotation: scheduled.UTC().Format(time.RFC3339),
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(schedule, av1.SchemeGroupVersion.WithKind("ArmadaBackupSchedule")),
+			},
+		},
+	}
+	schedule.Spec.Template.DeepCopyInto(&backup.Spec)
+	switch {
+	case backup.Spec.Offsite != nil:
+		backup.Spec.Offsite.Path = RunPath(backup.Spec.Offsite.Path, scheduled)
+	case backup.Spec.Ceph != nil:
+		backup.Spec.Ceph.Path = RunPath(backup.Spec.Ceph.Path, scheduled)
+	case backup.Spec.Local != nil:
+		backup.Spec.Local.Path = RunPath(backup.Spec.Local.Path, scheduled)
+	}
+	return backup
+}
+
+// RunPath inserts the scheduled time of a run before the extension of the
+// file name of a backup path: "mybucket/armada.backup" becomes
+// "mybucket/armada-20190602T020000Z.backup".
+func RunPath(p string, scheduled time.Time) string {
+	ext := path.Ext(p)
+	if ext == path.Base(p) {
+		ext = ""
+	}
+	return strings.TrimSuffix(p, ext) + "-" + scheduled.UTC().Format(runTimeFormat) + ext
+}
+
+// Expired returns the backups of a schedule which the retention expires,
+// oldest first. Only the finished backups, deployed, failed or in error,
+// are counted and expired: a backup in progress is always kept. Each one is
+// to be passed to Expire before being deleted.
+func Expired(schedule *av1.ArmadaBackupSchedule, backups []av1.ArmadaBackup, now time.Time) []av1.ArmadaBackup {
+	expired := make([]av1.ArmadaBackup, 0)
+	retention := schedule.Spec.Retention
+	if retention == nil {
+		return expired
+	}
+
+	finished := make([]av1.ArmadaBackup, 0, len(backups))
+	for _, backup := range backups {
+		if backup.Namespace != schedule.Namespace || backup.Labels[av1.BackupScheduleLabel] != schedule.Name {
+			continue
+		}
+		switch backup.Status.ActualState {
+		case av1.StateDeployed, av1.StateFailed, av1.StateError:
+			finished = append(finished, backup)
+		}
+	}
+	// Newest first
+	sort.SliceStable(finished, func(i, j int) bool {
+		ti, tj := finished[i].CreationTimestamp.Time, finished[j].CreationTimestamp.Time
+		if ti.Equal(tj) {
+			return finished[i].Name > finished[j].Name
+		}
+		return ti.After(tj)
+	})
+
+	for i, backup := range finished {
+		tooMany := retention.MaxCount > 0 && i >= int(retention.MaxCount)
+		tooOld := retention.MaxAge != nil && now.Sub(backup.CreationTimestamp.Time) > retention.MaxAge.Duration
+		if tooMany || tooOld {
+			expired = append(expired, backup)
+		}
+	}
+	for i, j := 0, len(expired)-1; i < j; i, j = i+1, j-1 {
+		expired[i], expired[j] = expired[j], expired[i]
+	}
+	return expired
+}
+
+// Expire deletes the archive of an expired backup from its storage, so
+// that the retention frees the storage and not only the ArmadaBackups. The
+// credentials are those of the secret of the backup source. A missing
+// archive is not an error.
+func Expire(ctx context.Context, backup *av1.ArmadaBackup, creds storage.Credentials) error {
+	backend, key, err := storage.ForBackup(&backup.Spec, creds)
+	if err != nil {
+		return err
+	}
+	return backend.Delete(ctx, key)
+}
@@ -0,0 +1,273 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schedule
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newSchedule(schedule string, created time.Time) *av1.ArmadaBackupSchedule {
+	return &av1.ArmadaBackupSchedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "nightly",
+			Namespace:         "openstack",
+			UID:               "3f1c2b9e",
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Spec: av1.ArmadaBackupScheduleSpec{
+			Schedule: schedule,
+			Template: av1.ArmadaBackupSpec{
+				StorageType:  av1.BackupStorageTypeLocal,
+				BackupSource: av1.BackupSource{Local: &av1.LocalBackupSource{Path: "/var/backups/armada.backup"}},
+			},
+		},
+	}
+}
+
+func newBackup(name string, created time.Time, state av1.HelmResourceState) av1.ArmadaBackup {
+	return av1.ArmadaBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "openstack",
+			Labels:            map[string]string{av1.BackupScheduleLabel: "nightly"},
+			CreationTimestamp: metav1.NewTime(created),
+		},
+		Status: av1.ArmadaBackupStatus{ArmadaStatus: av1.ArmadaStatus{ActualState: state}},
+	}
+}
+
+func TestParse(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	for _, schedule := range []string{"0 2 * * *", "*/15 * * * 1-5", "@daily", "CRON_TZ=Europe/Paris 30 3 * * 0"} {
+		_, err := Parse(schedule)
+		g.Expect(err).NotTo(gomega.HaveOccurred(), schedule)
+	}
+	for _, schedule := range []string{"", "0 2 * *", "61 * * * *", "0 0 2 * * *", "@sometimes"} {
+		_, err := Parse(schedule)
+		var parseErr *ParseError
+		g.Expect(errors.As(err, &parseErr)).To(gomega.BeTrue(), schedule)
+	}
+}
+
+func TestNextRun(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	created := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+	schedule := newSchedule("0 2 * * *", created)
+
+	// Nothing is due before the first scheduled time
+	run, err := NextRun(schedule, created.Add(time.Hour))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.Due.IsZero()).To(gomega.BeTrue())
+	g.Expect(run.Next).To(gomega.Equal(time.Date(2019, 6, 2, 2, 0, 0, 0, time.UTC)))
+
+	// Only the latest of the missed runs is due
+	now := time.Date(2019, 6, 4, 9, 0, 0, 0, time.UTC)
+	run, err = NextRun(schedule, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.Due).To(gomega.Equal(time.Date(2019, 6, 4, 2, 0, 0, 0, time.UTC)))
+	g.Expect(run.Next).To(gomega.Equal(time.Date(2019, 6, 5, 2, 0, 0, 0, time.UTC)))
+
+	// Once run, it is no longer due
+	schedule.Status.LastScheduleTime = &metav1.Time{Time: run.Due}
+	run, err = NextRun(schedule, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.Due.IsZero()).To(gomega.BeTrue())
+
+	// A scheduled time equal to now is due
+	run, err = NextRun(schedule, time.Date(2019, 6, 5, 2, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.Due).To(gomega.Equal(time.Date(2019, 6, 5, 2, 0, 0, 0, time.UTC)))
+
+	schedule.Spec.Suspend = true
+	run, err = NextRun(schedule, now.Add(72*time.Hour))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run).To(gomega.Equal(Run{}))
+
+	// A schedule which never fires has no run
+	schedule = newSchedule("0 0 30 2 *", created)
+	run, err = NextRun(schedule, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run).To(gomega.Equal(Run{}))
+
+	schedule.Spec.Schedule = "every night"
+	_, err = NextRun(schedule, now)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestNextRunTooManyMissed(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	created := time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	// Exactly MaxMissedRuns missed runs are walked through
+	schedule := newSchedule("* * * * *", created)
+	run, err := NextRun(schedule, created.Add(MaxMissedRuns*time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.TooManyMissed).To(gomega.BeFalse())
+	g.Expect(run.Due).To(gomega.Equal(created.Add(MaxMissedRuns * time.Minute)))
+
+	// A year of missed runs
+	now := time.Date(2020, 6, 1, 12, 34, 56, 500000000, time.UTC)
+	run, err = NextRun(schedule, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.TooManyMissed).To(gomega.BeTrue())
+	g.Expect(run.Due).To(gomega.Equal(time.Date(2020, 6, 1, 12, 34, 0, 0, time.UTC)))
+	g.Expect(run.Next).To(gomega.Equal(time.Date(2020, 6, 1, 12, 35, 0, 0, time.UTC)))
+
+	schedule.Spec.Schedule = "@every 1s"
+	run, err = NextRun(schedule, now)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.TooManyMissed).To(gomega.BeTrue())
+	g.Expect(run.Due).To(gomega.Equal(time.Date(2020, 6, 1, 12, 34, 56, 0, time.UTC)))
+
+	// The interval of the first runs, one day, is shorter than the gap
+	// before now, from Friday to Sunday
+	schedule.Spec.Schedule = "0 2 * * 1-5"
+	run, err = NextRun(schedule, time.Date(2020, 5, 31, 12, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.TooManyMissed).To(gomega.BeTrue())
+	g.Expect(run.Due).To(gomega.Equal(time.Date(2020, 5, 29, 2, 0, 0, 0, time.UTC)))
+}
+
+func TestNextRunTimeZone(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	paris, err := time.LoadLocation("Europe/Paris")
+	if err != nil {
+		t.Skip("no time zone database")
+	}
+	schedule := newSchedule("CRON_TZ=Europe/Paris 0 2 * * *", time.Date(2019, 6, 1, 0, 0, 0, 0, time.UTC))
+	run, err := NextRun(schedule, time.Date(2019, 6, 1, 1, 0, 0, 0, time.UTC))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(run.Next.Equal(time.Date(2019, 6, 2, 2, 0, 0, 0, paris))).To(gomega.BeTrue())
+}
+
+func TestNewBackup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	schedule := newSchedule("0 2 * * *", time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	schedule.Labels = map[string]string{"application": "openstack"}
+
+	scheduled := time.Date(2019, 6, 2, 2, 0, 0, 0, time.UTC)
+	backup := NewBackup(schedule, scheduled)
+	g.Expect(backup.Name).To(gomega.Equal("nightly-25990680"))
+	g.Expect(backup.Namespace).To(gomega.Equal("openstack"))
+	g.Expect(backup.Labels).To(gomega.Equal(map[string]string{
+		"application":           "openstack",
+		av1.BackupScheduleLabel: "nightly",
+	}))
+	g.Expect(backup.Annotations[av1.BackupScheduledAtAnnotation]).To(gomega.Equal("2019-06-02T02:00:00Z"))
+	g.Expect(metav1.IsControlledBy(backup, schedule)).To(gomega.BeTrue())
+	g.Expect(backup.Spec.StorageType).To(gomega.Equal(schedule.Spec.Template.StorageType))
+	g.Expect(backup.Spec.Local.Path).To(gomega.Equal("/var/backups/armada-20190602T020000Z.backup"))
+
+	// The template is copied
+	g.Expect(schedule.Spec.Template.Local.Path).To(gomega.Equal("/var/backups/armada.backup"))
+
+	// Two runs store their archives under distinct keys
+	_, first, err := storage.ForBackup(&NewBackup(schedule, scheduled).Spec, storage.Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	_, second, err := storage.ForBackup(&NewBackup(schedule, scheduled.Add(24*time.Hour)).Spec, storage.Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(first).NotTo(gomega.Equal(second))
+
+	schedule.Spec.Template = av1.ArmadaBackupSpec{
+		StorageType:  av1.BackupStorageTypeCeph,
+		BackupSource: av1.BackupSource{Ceph: &av1.CephBackupSource{Path: "mybucket/armada/armada.backup"}},
+	}
+	g.Expect(NewBackup(schedule, scheduled).Spec.Ceph.Path).To(gomega.Equal("mybucket/armada/armada-20190602T020000Z.backup"))
+}
+
+func TestRunPath(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	scheduled := time.Date(2019, 6, 2, 2, 0, 0, 0, time.FixedZone("CEST", 2*3600))
+
+	g.Expect(RunPath("mybucket/armada.backup", scheduled)).To(gomega.Equal("mybucket/armada-20190602T000000Z.backup"))
+	g.Expect(RunPath("mybucket/armada.tar.gz", scheduled)).To(gomega.Equal("mybucket/armada.tar-20190602T000000Z.gz"))
+	g.Expect(RunPath("armada/backup", scheduled)).To(gomega.Equal("armada/backup-20190602T000000Z"))
+	g.Expect(RunPath("armada/.backup", scheduled)).To(gomega.Equal("armada/.backup-20190602T000000Z"))
+	g.Expect(RunPath("my.bucket/backup", scheduled)).To(gomega.Equal("my.bucket/backup-20190602T000000Z"))
+}
+
+func TestExpire(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	dir := t.TempDir()
+	schedule := newSchedule("0 2 * * *", time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC))
+	schedule.Spec.Template.Local.Path = filepath.Join(dir, "armada.backup")
+
+	first := NewBackup(schedule, time.Date(2019, 6, 2, 2, 0, 0, 0, time.UTC))
+	second := NewBackup(schedule, time.Date(2019, 6, 3, 2, 0, 0, 0, time.UTC))
+	for _, backup := range []*av1.ArmadaBackup{first, second} {
+		g.Expect(os.WriteFile(backup.Spec.Local.Path, []byte("archive"), 0o600)).To(gomega.Succeed())
+	}
+
+	g.Expect(Expire(t.Context(), first, storage.Credentials{})).To(gomega.Succeed())
+	_, err := os.Stat(first.Spec.Local.Path)
+	g.Expect(os.IsNotExist(err)).To(gomega.BeTrue())
+	_, err = os.Stat(second.Spec.Local.Path)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// The archive is already gone
+	g.Expect(Expire(t.Context(), first, storage.Credentials{})).To(gomega.Succeed())
+}
+
+func TestExpired(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	now := time.Date(2019, 6, 10, 12, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+	schedule := newSchedule("0 2 * * *", now.Add(-30*day))
+
+	backups := []av1.ArmadaBackup{
+		newBackup("nightly-1", now.Add(-9*day), av1.StateDeployed),
+		newBackup("nightly-5", now.Add(-1*day), av1.StateRunning),
+		newBackup("nightly-2", now.Add(-8*day), av1.StateFailed),
+		newBackup("nightly-4", now.Add(-2*day), av1.StateDeployed),
+		newBackup("nightly-3", now.Add(-3*day), av1.StateDeployed),
+	}
+	other := newBackup("weekly-1", now.Add(-20*day), av1.StateDeployed)
+	other.Labels[av1.BackupScheduleLabel] = "weekly"
+	backups = append(backups, other)
+
+	names := func(backups []av1.ArmadaBackup) []string {
+		result := make([]string, 0, len(backups))
+		for _, backup := range backups {
+			result = append(result, backup.Name)
+		}
+		return result
+	}
+
+	// Without retention the backups are kept forever
+	g.Expect(Expired(schedule, backups, now)).To(gomega.BeEmpty())
+
+	schedule.Spec.Retention = &av1.BackupRetention{MaxCount: 2}
+	g.Expect(names(Expired(schedule, backups, now))).To(gomega.Equal([]string{"nightly-1", "nightly-2"}))
+
+	schedule.Spec.Retention = &av1.BackupRetention{MaxAge: &metav1.Duration{Duration: 7 * day}}
+	g.Expect(names(Expired(schedule, backups, now))).To(gomega.Equal([]string{"nightly-1", "nightly-2"}))
+
+	schedule.Spec.Retention = &av1.BackupRetention{MaxCount: 3, MaxAge: &metav1.Duration{Duration: 2*day + time.Hour}}
+	g.Expect(names(Expired(schedule, backups, now))).To(gomega.Equal([]string{"nightly-1", "nightly-2", "nightly-3"}))
+
+	// A backup in progress is never expired
+	schedule.Spec.Retention = &av1.BackupRetention{MaxAge: &metav1.Duration{Duration: time.Hour}}
+	g.Expect(names(Expired(schedule, backups, now))).NotTo(gomega.ContainElement("nightly-5"))
+}
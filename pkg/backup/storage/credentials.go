@@ -0,0 +1,113 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+)
+
+// Credentials authenticate the requests to an S3 compatible store. Empty
+// credentials send anonymous requests.
+type Credentials struct {
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is set along with temporary keys, such as those issued
+	// by the AWS Security Token Service
+	SessionToken string
+	// Region defaults to DefaultRegion
+	Region string
+}
+
+// OffsiteCredentials reads the data of an OffsiteSecret: the "credentials"
+// and "config" files of the AWS command line, of which the "default"
+// profile is used. Its aws_session_token is set with temporary keys.
+func OffsiteCredentials(data map[string][]byte) (Credentials, error) {
+	creds := Credentials{}
+	credentials, ok := data[av1.OffsiteSecretCredentialsFileName]
+	if !ok {
+		return creds, fmt.Errorf("offsite secret: missing %q", av1.OffsiteSecretCredentialsFileName)
+	}
+	profile := iniSection(credentials, "default")
+	creds.AccessKeyID = profile["aws_access_key_id"]
+	creds.SecretAccessKey = profile["aws_secret_access_key"]
+	creds.SessionToken = profile["aws_session_token"]
+	if creds.AccessKeyID == "" || creds.SecretAccessKey == "" {
+		return creds, fmt.Errorf("offsite secret: no access key in the default profile of %q", av1.OffsiteSecretCredentialsFileName)
+	}
+	if config, ok := data[av1.OffsiteSecretConfigFileName]; ok {
+		// The config file prefixes the profiles but the default one
+		profile := iniSection(config, "default")
+		if len(profile) == 0 {
+			profile = iniSection(config, "profile default")
+		}
+		creds.Region = profile["region"]
+	}
+	return creds, nil
+}
+
+// CephCredentials reads the data of a CephSecret, whose "credentials.json"
+// holds the keys of the RGW user, as printed by radosgw-admin:
+// {"access_key": "...", "secret_key": "..."}
+func CephCredentials(data map[string][]byte) (Credentials, error) {
+	creds := Credentials{}
+	if _, ok := data[av1.CephAccessToken]; ok {
+		return creds, fmt.Errorf("ceph secret: %q is not supported by the RADOS gateway, use %q",
+			av1.CephAccessToken, av1.CephCredentialsJson)
+	}
+	blob, ok := data[av1.CephCredentialsJson]
+	if !ok {
+		return creds, fmt.Errorf("ceph secret: missing %q", av1.CephCredentialsJson)
+	}
+	keys := struct {
+		AccessKey string `json:"access_key"`
+		SecretKey string `json:"secret_key"`
+	}{}
+	if err := json.Unmarshal(blob, &keys); err != nil {
+		return creds, fmt.Errorf("ceph secret: %q: %v", av1.CephCredentialsJson, err)
+	}
+	if keys.AccessKey == "" || keys.SecretKey == "" {
+		return creds, fmt.Errorf("ceph secret: %q must hold access_key and secret_key", av1.CephCredentialsJson)
+	}
+	creds.AccessKeyID = keys.AccessKey
+	creds.SecretAccessKey = keys.SecretKey
+	return creds, nil
+}
+
+// iniSection returns the keys of a section of an INI file
+func iniSection(data []byte, name string) map[string]string {
+	res := make(map[string]string)
+	current := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			current = strings.TrimSpace(line[1 : len(line)-1])
+		case current == name:
+			if eq := strings.Index(line, "="); eq > 0 {
+				res[strings.TrimSpace(line[:eq])] = strings.TrimSpace(line[eq+1:])
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,22 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storage stores the backups of ArmadaBackup and reads them back
+// for ArmadaRestore. A Backend puts, gets, lists and deletes objects by key:
+// the objects of a bucket for the S3 compatible stores, Offsite and Ceph
+// RGW, or the files below a directory for Local.
+//
+// The Offsite and Ceph paths are of the form "<bucket>/<key>", see
+// ParseBucketPath.
+package storage
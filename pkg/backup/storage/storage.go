@@ -0,0 +1,292 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Backend stores objects by key. Keys are slash separated relative paths,
+// see ValidateKey.
+type Backend interface {
+	// Put stores the size bytes of r under key, replacing any object
+	Put(ctx context.Context, key string, r io.Reader, size int64) error
+	// Get returns the content of an object, or a NotFoundError
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// List returns the objects whose key starts with prefix, sorted by key
+	List(ctx context.Context, prefix string) ([]Object, error)
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+}
+
+// Object describes a stored object
+type Object struct {
+	Key          string
+	Size         int64
+	LastModified time.Time
+}
+
+// NotFoundError reports a missing object
+type NotFoundError struct {
+	Key string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("backup %q not found", e.Key)
+}
+
+// PathError reports an invalid backup path
+type PathError struct {
+	Path   string
+	Reason string
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("invalid path %q: %s", e.Path, e.Reason)
+}
+
+const maxKeyLength = 1024
+
+var bucketName = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
+// ParseBucketPath splits a path of the form "<bucket>/<key>", such as
+// "mybucket/armada/armada.backup", and validates both parts.
+func ParseBucketPath(p string) (string, string, error) {
+	parts := strings.SplitN(p, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", &PathError{Path: p, Reason: "must be of the form <bucket-name>/<path-to-backup-file>"}
+	}
+	if err := ValidateBucket(parts[0]); err != nil {
+		return "", "", &PathError{Path: p, Reason: err.Error()}
+	}
+	if err := ValidateKey(parts[1]); err != nil {
+		return "", "", &PathError{Path: p, Reason: err.Error()}
+	}
+	return parts[0], parts[1], nil
+}
+
+// ValidateBucket checks a bucket name follows the S3 naming rules
+func ValidateBucket(bucket string) error {
+	if !bucketName.MatchString(bucket) || strings.Contains(bucket, "..") {
+		return fmt.Errorf("bucket name %q must be 3 to 63 lowercase letters, digits, dots and hyphens", bucket)
+	}
+	return nil
+}
+
+// ValidateKey checks a key is a clean relative path: no leading slash, no
+// empty, "." or ".." element.
+func ValidateKey(key string) error {
+	if key == "" {
+		return fmt.Errorf("key must not be empty")
+	}
+	if len(key) > maxKeyLength {
+		return fmt.Errorf("key must be at most %d bytes", maxKeyLength)
+	}
+	if strings.ContainsRune(key, 0) {
+		return fmt.Errorf("key must not contain NUL")
+	}
+	for _, element := range strings.Split(key, "/") {
+		if element == "" || element == "." || element == ".." {
+			return fmt.Errorf("key %q must be a relative path without empty, '.' or '..' elements", key)
+		}
+	}
+	return nil
+}
+
+// ValidateLocalPath checks the path of a Local backup: a key when the
+// backup is on a volume, an absolute clean path otherwise.
+func ValidateLocalPath(p string, onVolume bool) error {
+	if onVolume {
+		if err := ValidateKey(p); err != nil {
+			return &PathError{Path: p, Reason: err.Error()}
+		}
+		return nil
+	}
+	if !path.IsAbs(p) || path.Clean(p) != p || p == "/" {
+		return &PathError{Path: p, Reason: "must be an absolute path to the backup file"}
+	}
+	return nil
+}
+
+// StorageTypes are the storage types supported by the backends
+var StorageTypes = sets.New(av1.BackupStorageTypeOffsite, av1.BackupStorageTypeCeph, av1.BackupStorageTypeLocal)
+
+// Location is where a backup is stored: the storage type of an ArmadaBackup
+// or an ArmadaRestore and the fields of the source it selects.
+type Location struct {
+	StorageType           av1.BackupStorageType
+	Path                  string
+	Endpoint              string
+	ForcePathStyle        bool
+	PersistentVolumeClaim string
+}
+
+// Resolve returns the location of the source selected by storageType and
+// checks its path. typePath is the path of the storage type field and
+// fldPath the path of the struct holding the sources. The admission
+// webhook reports the errors as they are, ForBackup and ForRestore as an
+// aggregate.
+func Resolve(storageType av1.BackupStorageType, offsite *av1.OffsiteBackupSource, ceph *av1.CephBackupSource, local *av1.LocalBackupSource, typePath *field.Path, fldPath *field.Path) (*Location, field.ErrorList) {
+	location := &Location{StorageType: storageType}
+	switch {
+	case storageType == "":
+		return nil, field.ErrorList{field.Required(typePath, "")}
+	case !StorageTypes.Has(storageType):
+		return nil, field.ErrorList{field.NotSupported(typePath, storageType, sets.List(StorageTypes))}
+	case storageType == av1.BackupStorageTypeOffsite:
+		if offsite == nil {
+			return nil, field.ErrorList{field.Required(fldPath.Child("offsite"), fmt.Sprintf("required when %s is %s", typePath, storageType))}
+		}
+		location.Path = offsite.Path
+		location.Endpoint = offsite.Endpoint
+		location.ForcePathStyle = offsite.ForcePathStyle
+		return location, validateBucketPath(offsite.Path, fldPath.Child("offsite", "path"))
+	case storageType == av1.BackupStorageTypeCeph:
+		if ceph == nil {
+			return nil, field.ErrorList{field.Required(fldPath.Child("ceph"), fmt.Sprintf("required when %s is %s", typePath, storageType))}
+		}
+		location.Path = ceph.Path
+		location.Endpoint = ceph.Endpoint
+		return location, validateBucketPath(ceph.Path, fldPath.Child("ceph", "path"))
+	default:
+		if local == nil {
+			return nil, field.ErrorList{field.Required(fldPath.Child("local"), fmt.Sprintf("required when %s is %s", typePath, storageType))}
+		}
+		location.Path = local.Path
+		location.PersistentVolumeClaim = local.PersistentVolumeClaim
+		return location, validateLocalPath(local.Path, local.PersistentVolumeClaim != "", fldPath.Child("local", "path"))
+	}
+}
+
+// ForBackup returns the backend of an ArmadaBackup and the key of its
+// backup. The credentials are those of the secret of the source, see
+// OffsiteCredentials and CephCredentials.
+func ForBackup(spec *av1.ArmadaBackupSpec, creds Credentials) (Backend, string, error) {
+	specPath := field.NewPath("spec")
+	location, errs := Resolve(spec.StorageType, spec.Offsite, spec.Ceph, spec.Local, specPath.Child("storageType"), specPath)
+	if len(errs) != 0 {
+		return nil, "", errs.ToAggregate()
+	}
+	return forLocation(location, creds)
+}
+
+// ForRestore returns the backend of an ArmadaRestore and the key of the
+// backup it restores, see ForBackup.
+func ForRestore(spec *av1.ArmadaRestoreSpec, creds Credentials) (Backend, string, error) {
+	specPath := field.NewPath("spec")
+	location, errs := Resolve(spec.BackupStorageType,
+		(*av1.OffsiteBackupSource)(spec.Offsite),
+		(*av1.CephBackupSource)(spec.Ceph),
+		(*av1.LocalBackupSource)(spec.Local),
+		specPath.Child("backupStorageType"), specPath)
+	if len(errs) != 0 {
+		return nil, "", errs.ToAggregate()
+	}
+	return forLocation(location, creds)
+}
+
+// forLocation returns the backend of a location returned by Resolve and
+// the key of the backup.
+func forLocation(location *Location, creds Credentials) (Backend, string, error) {
+	switch location.StorageType {
+	case av1.BackupStorageTypeOffsite:
+		return forOffsite(location.Path, location.Endpoint, location.ForcePathStyle, creds)
+	case av1.BackupStorageTypeCeph:
+		return forCeph(location.Path, location.Endpoint, creds)
+	case av1.BackupStorageTypeLocal:
+		return forLocal(location.Path, location.PersistentVolumeClaim)
+	}
+	return nil, "", fmt.Errorf("unsupported storage type %q", location.StorageType)
+}
+
+func forOffsite(p string, endpoint string, forcePathStyle bool, creds Credentials) (Backend, string, error) {
+	bucket, key, err := ParseBucketPath(p)
+	if err != nil {
+		return nil, "", err
+	}
+	backend, err := NewS3(S3Config{
+		Endpoint:       endpoint,
+		Region:         creds.Region,
+		Bucket:         bucket,
+		ForcePathStyle: forcePathStyle,
+		Credentials:    creds,
+	})
+	return backend, key, err
+}
+
+func forCeph(p string, endpoint string, creds Credentials) (Backend, string, error) {
+	bucket, key, err := ParseBucketPath(p)
+	if err != nil {
+		return nil, "", err
+	}
+	backend, err := NewCephRGW(endpoint, bucket, creds)
+	return backend, key, err
+}
+
+func forLocal(p string, claim string) (Backend, string, error) {
+	if err := ValidateLocalPath(p, claim != ""); err != nil {
+		return nil, "", err
+	}
+	if claim != "" {
+		return NewLocal(av1.LocalVolumeMountPath), p, nil
+	}
+	return NewLocal(filepath.Dir(filepath.FromSlash(p))), path.Base(p), nil
+}
+
+// validateBucketPath checks the "<bucket-name>/<path-to-backup-file>" format
+func validateBucketPath(p string, fldPath *field.Path) field.ErrorList {
+	if p == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	if _, _, err := ParseBucketPath(p); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, p, pathErrorReason(err))}
+	}
+	return nil
+}
+
+// validateLocalPath checks the path of a Local backup, relative to the
+// volume when a claim is given and absolute otherwise
+func validateLocalPath(p string, onVolume bool, fldPath *field.Path) field.ErrorList {
+	if p == "" {
+		return field.ErrorList{field.Required(fldPath, "")}
+	}
+	if err := ValidateLocalPath(p, onVolume); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, p, pathErrorReason(err))}
+	}
+	return nil
+}
+
+// pathErrorReason returns the reason of a PathError without the path,
+// which field.Invalid already prints, and the whole message of any other
+// error
+func pathErrorReason(err error) string {
+	var pathErr *PathError
+	if errors.As(err, &pathErr) {
+		return pathErr.Reason
+	}
+	return err.Error()
+}
@@ -0,0 +1,213 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestParseBucketPath(t *testing.T) {
+	tests := []struct {
+		path   string
+		bucket string
+		key    string
+		valid  bool
+	}{
+		{"mybucket/armada.backup", "mybucket", "armada.backup", true},
+		{"my.bucket-1/armada/2019/armada.backup", "my.bucket-1", "armada/2019/armada.backup", true},
+		{"mybucket", "", "", false},
+		{"mybucket/", "", "", false},
+		{"/armada.backup", "", "", false},
+		{"MyBucket/armada.backup", "", "", false},
+		{"ab/armada.backup", "", "", false},
+		{"my..bucket/armada.backup", "", "", false},
+		{"-bucket/armada.backup", "", "", false},
+		{"mybucket/armada//armada.backup", "", "", false},
+		{"mybucket/../armada.backup", "", "", false},
+		{"mybucket/armada/./armada.backup", "", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			bucket, key, err := ParseBucketPath(tt.path)
+			if !tt.valid {
+				var pathErr *PathError
+				g.Expect(err).To(gomega.BeAssignableToTypeOf(pathErr))
+				return
+			}
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(bucket).To(gomega.Equal(tt.bucket))
+			g.Expect(key).To(gomega.Equal(tt.key))
+		})
+	}
+}
+
+func TestValidateLocalPath(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	g.Expect(ValidateLocalPath("armada/armada.backup", true)).To(gomega.Succeed())
+	g.Expect(ValidateLocalPath("/armada/armada.backup", true)).NotTo(gomega.Succeed())
+	g.Expect(ValidateLocalPath("/var/backups/armada.backup", false)).To(gomega.Succeed())
+	g.Expect(ValidateLocalPath("armada.backup", false)).NotTo(gomega.Succeed())
+	g.Expect(ValidateLocalPath("/var/backups/../armada.backup", false)).NotTo(gomega.Succeed())
+	g.Expect(ValidateLocalPath("/", false)).NotTo(gomega.Succeed())
+}
+
+func TestCredentials(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	creds, err := OffsiteCredentials(map[string][]byte{
+		"credentials": []byte("[other]\naws_access_key_id = OTHER\n\n[default]\naws_access_key_id = AKID\naws_secret_access_key = secret\n"),
+		"config":      []byte("[default]\nregion = eu-west-1\n"),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(creds).To(gomega.Equal(Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", Region: "eu-west-1"}))
+	creds, err = OffsiteCredentials(map[string][]byte{
+		"credentials": []byte("[default]\naws_access_key_id = ASIA\naws_secret_access_key = secret\naws_session_token = token\n"),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(creds).To(gomega.Equal(Credentials{AccessKeyID: "ASIA", SecretAccessKey: "secret", SessionToken: "token"}))
+	_, err = OffsiteCredentials(map[string][]byte{"credentials": []byte("[other]\naws_access_key_id = OTHER\n")})
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = OffsiteCredentials(map[string][]byte{})
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	creds, err = CephCredentials(map[string][]byte{
+		"credentials.json": []byte(`{"user": "armada", "access_key": "AKID", "secret_key": "secret"}`),
+	})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(creds).To(gomega.Equal(Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret"}))
+	_, err = CephCredentials(map[string][]byte{"access-token": []byte("token")})
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, err = CephCredentials(map[string][]byte{"credentials.json": []byte(`{"access_key": "AKID"}`)})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestResolve(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	specPath := field.NewPath("spec")
+	typePath := specPath.Child("backupStorageType")
+
+	location, errs := Resolve(av1.BackupStorageTypeOffsite,
+		&av1.OffsiteBackupSource{Path: "mybucket/armada.backup", Endpoint: "https://minio.example.com", ForcePathStyle: true},
+		nil, nil, typePath, specPath)
+	g.Expect(errs).To(gomega.BeEmpty())
+	g.Expect(location).To(gomega.Equal(&Location{
+		StorageType:    av1.BackupStorageTypeOffsite,
+		Path:           "mybucket/armada.backup",
+		Endpoint:       "https://minio.example.com",
+		ForcePathStyle: true,
+	}))
+
+	location, errs = Resolve(av1.BackupStorageTypeLocal, nil, nil,
+		&av1.LocalBackupSource{Path: "armada/armada.backup", PersistentVolumeClaim: "backups"}, typePath, specPath)
+	g.Expect(errs).To(gomega.BeEmpty())
+	g.Expect(location.PersistentVolumeClaim).To(gomega.Equal("backups"))
+
+	tests := []struct {
+		storageType av1.BackupStorageType
+		ceph        *av1.CephBackupSource
+		errType     field.ErrorType
+		errField    string
+	}{
+		{"", nil, field.ErrorTypeRequired, "spec.backupStorageType"},
+		{"S3", nil, field.ErrorTypeNotSupported, "spec.backupStorageType"},
+		{av1.BackupStorageTypeCeph, nil, field.ErrorTypeRequired, "spec.ceph"},
+		{av1.BackupStorageTypeCeph, &av1.CephBackupSource{}, field.ErrorTypeRequired, "spec.ceph.path"},
+		{av1.BackupStorageTypeCeph, &av1.CephBackupSource{Path: "MyBucket/armada.backup"}, field.ErrorTypeInvalid, "spec.ceph.path"},
+	}
+	for _, tt := range tests {
+		_, errs := Resolve(tt.storageType, nil, tt.ceph, nil, typePath, specPath)
+		g.Expect(errs).To(gomega.HaveLen(1), "%v", tt)
+		g.Expect(errs[0].Type).To(gomega.Equal(tt.errType), "%v", tt)
+		g.Expect(errs[0].Field).To(gomega.Equal(tt.errField), "%v", tt)
+	}
+}
+
+func TestForBackupAndRestore(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	backend, key, err := ForBackup(&av1.ArmadaBackupSpec{
+		StorageType: av1.BackupStorageTypeOffsite,
+		BackupSource: av1.BackupSource{Offsite: &av1.OffsiteBackupSource{
+			Path:     "mybucket/armada/armada.backup",
+			Endpoint: "https://minio.example.com",
+		}},
+	}, Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(key).To(gomega.Equal("armada/armada.backup"))
+	g.Expect(backend.(*S3).config.ForcePathStyle).To(gomega.BeFalse())
+	g.Expect(backend.(*S3).config.Region).To(gomega.Equal(DefaultRegion))
+
+	backend, _, err = ForBackup(&av1.ArmadaBackupSpec{
+		StorageType:  av1.BackupStorageTypeOffsite,
+		BackupSource: av1.BackupSource{Offsite: &av1.OffsiteBackupSource{Path: "mybucket/armada.backup"}},
+	}, Credentials{Region: "eu-west-1"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(backend.(*S3).endpoint.String()).To(gomega.Equal("https://s3.eu-west-1.amazonaws.com"))
+
+	backend, key, err = ForRestore(&av1.ArmadaRestoreSpec{
+		BackupStorageType: av1.BackupStorageTypeCeph,
+		RestoreSource: av1.RestoreSource{Ceph: &av1.CephRestoreSource{
+			Path:     "mycephbucket/armada.backup",
+			Endpoint: "http://ceph-rgw.ceph.svc.cluster.local:8088",
+		}},
+	}, Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(key).To(gomega.Equal("armada.backup"))
+	g.Expect(backend.(*S3).config.ForcePathStyle).To(gomega.BeTrue())
+
+	backend, key, err = ForRestore(&av1.ArmadaRestoreSpec{
+		BackupStorageType: av1.BackupStorageTypeLocal,
+		RestoreSource: av1.RestoreSource{Local: &av1.LocalRestoreSource{
+			Path:                  "armada/armada.backup",
+			PersistentVolumeClaim: "backups",
+		}},
+	}, Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(key).To(gomega.Equal("armada/armada.backup"))
+	g.Expect(backend.(*Local).root).To(gomega.Equal(av1.LocalVolumeMountPath))
+
+	backend, key, err = ForBackup(&av1.ArmadaBackupSpec{
+		StorageType:  av1.BackupStorageTypeLocal,
+		BackupSource: av1.BackupSource{Local: &av1.LocalBackupSource{Path: "/var/backups/armada.backup"}},
+	}, Credentials{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(key).To(gomega.Equal("armada.backup"))
+	g.Expect(backend.(*Local).root).To(gomega.Equal("/var/backups"))
+
+	_, _, err = ForBackup(&av1.ArmadaBackupSpec{StorageType: av1.BackupStorageTypeCeph}, Credentials{})
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, _, err = ForRestore(&av1.ArmadaRestoreSpec{
+		BackupStorageType: av1.BackupStorageTypeCeph,
+		RestoreSource:     av1.RestoreSource{Ceph: &av1.CephRestoreSource{Path: "mycephbucket/armada.backup"}},
+	}, Credentials{})
+	g.Expect(err).To(gomega.HaveOccurred())
+	_, _, err = ForBackup(&av1.ArmadaBackupSpec{StorageType: "S3"}, Credentials{})
+	g.Expect(err).To(gomega.HaveOccurred())
+}
+
+func TestLocal(t *testing.T) {
+	testBackend(t, NewLocal(t.TempDir()))
+
+	// A missing directory has no backups
+	g := gomega.NewGomegaWithT(t)
+	objects, err := NewLocal(t.TempDir()+"/missing").List(t.Context(), "")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(objects).To(gomega.BeEmpty())
+}
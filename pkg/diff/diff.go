@@ -0,0 +1,259 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ChangeType tells how a field changed
+type ChangeType string
+
+// Types of change
+const (
+	Added    ChangeType = "Added"
+	Removed  ChangeType = "Removed"
+	Modified ChangeType = "Modified"
+)
+
+// Redacted replaces the values of the secrets
+const Redacted = "<redacted>"
+
+// Change is a field which differs between the two specs
+type Change struct {
+	// Path of the field, its keys separated by dots as in the --set
+	// expressions of helm: a literal dot is escaped and a list index
+	// is written [i].
+	Path string     `json:"path"`
+	Type ChangeType `json:"type"`
+	// From is the former value, absent if the field was added
+	From interface{} `json:"from,omitempty"`
+	// To is the new value, absent if the field was removed
+	To interface{} `json:"to,omitempty"`
+	// Secret is true if the values were redacted
+	Secret bool `json:"secret,omitempty"`
+}
+
+// Options of Compare
+type Options struct {
+	// Prefix is prepended to the paths, "spec" for instance
+	Prefix string
+	// ShowSecrets disables the redaction of the secrets
+	ShowSecrets bool
+}
+
+// secretKeys are the fragments of the keys holding secrets. A key is
+// compared in lower case, without its dashes and underscores.
+var secretKeys = []string{
+	"password", "passwd", "secret", "token", "credential",
+	"apikey", "authkey", "accesskey", "privatekey",
+}
+
+// IsSecretKey returns true if the key looks like the name of a secret
+func IsSecretKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, fragment := range secretKeys {
+		if strings.Contains(normalized, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsSecretValue returns true if the value looks like a secret whatever its
+// key: a PEM encoded key or certificate.
+func IsSecretValue(value interface{}) bool {
+	s, ok := value.(string)
+	return ok && strings.Contains(s, "-----BEGIN ")
+}
+
+// Compare returns the changes from one spec to another. The specs are
+// compared through their JSON encoding; the changes are sorted by path.
+func Compare(from interface{}, to interface{}, opts Options) ([]Change, error) {
+	a, err := normalize(from)
+	if err != nil {
+		return nil, err
+	}
+	b, err := normalize(to)
+	if err != nil {
+		return nil, err
+	}
+	w := &walker{opts: opts, changes: make([]Change, 0)}
+	var path []string
+	if opts.Prefix != "" {
+		path = []string{opts.Prefix}
+	}
+	w.walk(path, false, a, b)
+	return w.changes, nil
+}
+
+// normalize converts a spec into the generic JSON types
+func normalize(spec interface{}) (interface{}, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var res interface{}
+	if err := json.Unmarshal(data, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+type walker struct {
+	opts    Options
+	changes []Change
+}
+
+// walk compares a and b at path. A nil value is an absent field. secret
+// is true below a key which looks like a secret, such as "secrets".
+func (w *walker) walk(path []string, secret bool, a interface{}, b interface{}) {
+	if a == nil && b == nil {
+		return
+	}
+	ma, aIsMap := a.(map[string]interface{})
+	mb, bIsMap := b.(map[string]interface{})
+	if aIsMap && bIsMap {
+		for _, k := range sortedKeys(ma, mb) {
+			w.walk(append(path[:len(path):len(path)], escape(k)), secret || IsSecretKey(k), ma[k], mb[k])
+		}
+		return
+	}
+	la, aIsList := a.([]interface{})
+	lb, bIsList := b.([]interface{})
+	if aIsList && bIsList {
+		for i := 0; i < len(la) || i < len(lb); i++ {
+			var ea, eb interface{}
+			if i < len(la) {
+				ea = la[i]
+			}
+			if i < len(lb) {
+				eb = lb[i]
+			}
+			w.walk(index(path, i), secret, ea, eb)
+		}
+		return
+	}
+	if reflect.DeepEqual(a, b) {
+		return
+	}
+
+	change := Change{Path: strings.Join(path, "."), From: a, To: b}
+	switch {
+	case a == nil:
+		change.Type = Added
+	case b == nil:
+		change.Type = Removed
+	default:
+		change.Type = Modified
+	}
+	if !w.opts.ShowSecrets && (secret || IsSecretValue(a) || IsSecretValue(b) || containsSecret(a) || containsSecret(b)) {
+		change.Secret = true
+		if a != nil {
+			change.From = Redacted
+		}
+		if b != nil {
+			change.To = Redacted
+		}
+	}
+	w.changes = append(w.changes, change)
+}
+
+// containsSecret returns true if a value added, removed or replaced as a
+// whole holds a secret.
+func containsSecret(value interface{}) bool {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if IsSecretKey(k) || IsSecretValue(item) || containsSecret(item) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if IsSecretValue(item) || containsSecret(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func sortedKeys(a map[string]interface{}, b map[string]interface{}) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escape(key string) string {
+	return strings.ReplaceAll(key, ".", `\.`)
+}
+
+// index appends [i] to the last key of path
+func index(path []string, i int) []string {
+	res := append([]string(nil), path...)
+	suffix := "[" + strconv.Itoa(i) + "]"
+	if len(res) == 0 {
+		return []string{suffix}
+	}
+	res[len(res)-1] += suffix
+	return res
+}
+
+// Write prints the changes one per line: "+" for an added field, "-" for a
+// removed one and "~" for a modified one, followed by the path and the
+// values in JSON.
+func Write(w io.Writer, changes []Change) error {
+	for _, c := range changes {
+		var line string
+		switch c.Type {
+		case Added:
+			line = fmt.Sprintf("+ %s: %s", c.Path, render(c.To))
+		case Removed:
+			line = fmt.Sprintf("- %s: %s", c.Path, render(c.From))
+		default:
+			line = fmt.Sprintf("~ %s: %s -> %s", c.Path, render(c.From), render(c.To))
+		}
+		if _, err := fmt.Fprintln(w, line); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func render(value interface{}) string {
+	if value == Redacted {
+		return Redacted
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
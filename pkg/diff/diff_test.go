@@ -0,0 +1,154 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import (
+	"bytes"
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+)
+
+func newSpec() *av1.ArmadaChartSpec {
+	return &av1.ArmadaChartSpec{
+		ChartName: "keystone",
+		Release:   "keystone",
+		Source: &av1.ArmadaChartSource{
+			Type:      "git",
+			Location:  "https://opendev.org/openstack/openstack-helm",
+			Reference: "master",
+			Subpath:   "keystone",
+		},
+		Dependencies: []string{"helm-toolkit"},
+		Wait:         &av1.ArmadaWait{Timeout: 600},
+		TargetState:  av1.StateDeployed,
+	}
+}
+
+func withValues(spec *av1.ArmadaChartSpec, values map[string]interface{}) *av1.ArmadaChartSpec {
+	v, err := av1.NewArmadaChartValues(values)
+	if err != nil {
+		panic(err)
+	}
+	spec.Values = v
+	return spec
+}
+
+func TestCompare(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	changes, err := Compare(newSpec(), newSpec(), Options{Prefix: "spec"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changes).To(gomega.BeEmpty())
+
+	from := withValues(newSpec(), map[string]interface{}{
+		"pod":         map[string]interface{}{"replicas": map[string]interface{}{"api": 1}},
+		"conf.d":      "a",
+		"annotations": []interface{}{"a", "b"},
+	})
+	to := withValues(newSpec(), map[string]interface{}{
+		"pod":         map[string]interface{}{"replicas": map[string]interface{}{"api": 2}},
+		"annotations": []interface{}{"a", "c", "d"},
+	})
+	to.Source.Reference = "stable/2024.1"
+	to.Wait = nil
+	to.Dependencies = append(to.Dependencies, "mariadb")
+	to.Upgrade = &av1.ArmadaUpgrade{Pre: &av1.ArmadaUpgradePre{Delete: []*av1.ArmadaHookActionItems{{Type: "job"}}}}
+
+	changes, err = Compare(from, to, Options{Prefix: "spec"})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changes).To(gomega.Equal([]Change{
+		{Path: "spec.dependencies[1]", Type: Added, To: "mariadb"},
+		{Path: "spec.source.reference", Type: Modified, From: "master", To: "stable/2024.1"},
+		{Path: "spec.upgrade", Type: Added, To: map[string]interface{}{
+			"no_hooks": false,
+			"pre":      map[string]interface{}{"delete": []interface{}{map[string]interface{}{"type": "job"}}},
+		}},
+		{Path: "spec.values.annotations[1]", Type: Modified, From: "b", To: "c"},
+		{Path: "spec.values.annotations[2]", Type: Added, To: "d"},
+		{Path: `spec.values.conf\.d`, Type: Removed, From: "a"},
+		{Path: "spec.values.pod.replicas.api", Type: Modified, From: float64(1), To: float64(2)},
+		{Path: "spec.wait", Type: Removed, From: map[string]interface{}{"timeout": float64(600)}},
+	}))
+}
+
+func TestCompareRedactsSecrets(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	from := withValues(newSpec(), map[string]interface{}{
+		"endpoints": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"auth": map[string]interface{}{"admin": map[string]interface{}{"password": "old", "username": "admin"}},
+			},
+		},
+		"conf": map[string]interface{}{"tls": "-----BEGIN CERTIFICATE-----\nold"},
+	})
+	to := withValues(newSpec(), map[string]interface{}{
+		"endpoints": map[string]interface{}{
+			"identity": map[string]interface{}{
+				"auth": map[string]interface{}{"admin": map[string]interface{}{"password": "new", "username": "root"}},
+			},
+		},
+		"conf":    map[string]interface{}{"tls": "-----BEGIN CERTIFICATE-----\nnew"},
+		"secrets": map[string]interface{}{"tls": map[string]interface{}{"name": "keystone-tls"}},
+	})
+
+	changes, err := Compare(from, to, Options{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changes).To(gomega.Equal([]Change{
+		{Path: "values.conf.tls", Type: Modified, From: Redacted, To: Redacted, Secret: true},
+		{Path: "values.endpoints.identity.auth.admin.password", Type: Modified, From: Redacted, To: Redacted, Secret: true},
+		{Path: "values.endpoints.identity.auth.admin.username", Type: Modified, From: "admin", To: "root"},
+		{Path: "values.secrets", Type: Added, To: Redacted, Secret: true},
+	}))
+
+	changes, err = Compare(from, to, Options{ShowSecrets: true})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changes[1].To).To(gomega.Equal("new"))
+	g.Expect(changes[1].Secret).To(gomega.BeFalse())
+}
+
+func TestIsSecretKey(t *testing.T) {
+	for key, want := range map[string]bool{
+		"password":      true,
+		"db_password":   true,
+		"apiKey":        true,
+		"private-key":   true,
+		"auth_token":    true,
+		"secrets":       true,
+		"keystone":      false,
+		"username":      false,
+		"key_rotations": false,
+	} {
+		if IsSecretKey(key) != want {
+			t.Errorf("IsSecretKey(%q) should be %t", key, want)
+		}
+	}
+}
+
+func TestWrite(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	out := &bytes.Buffer{}
+	g.Expect(Write(out, []Change{
+		{Path: "spec.dependencies[1]", Type: Added, To: "mariadb"},
+		{Path: "spec.wait", Type: Removed, From: map[string]interface{}{"timeout": 600}},
+		{Path: "spec.values.password", Type: Modified, From: Redacted, To: Redacted, Secret: true},
+	})).To(gomega.Succeed())
+	g.Expect(out.String()).To(gomega.Equal(`+ spec.dependencies[1]: "mariadb"
+- spec.wait: {"timeout":600}
+~ spec.values.password: <redacted> -> <redacted>
+`))
+}
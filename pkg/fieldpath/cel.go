@@ -0,0 +1,105 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldpath
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/ast"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+)
+
+// CELCostLimit bounds the runtime cost of the evaluation of a CEL
+// expression, as the API server bounds the one of a validation rule.
+const CELCostLimit = 1000000
+
+type celExpression struct {
+	expr    string
+	program cel.Program
+	// accesses are the IDs of the field selections and indexes of the
+	// expression, whose errors are missing fields
+	accesses map[int64]bool
+}
+
+func compileCEL(expr string) (Expression, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, &ParseError{Expression: expr, Err: err}
+	}
+	compiled, issues := env.Compile(strings.TrimPrefix(expr, CELPrefix))
+	if issues != nil && issues.Err() != nil {
+		return nil, &ParseError{Expression: expr, Err: issues.Err()}
+	}
+	program, err := env.Program(compiled, cel.CostLimit(CELCostLimit))
+	if err != nil {
+		return nil, &ParseError{Expression: expr, Err: err}
+	}
+	accesses := make(map[int64]bool)
+	ast.PreOrderVisit(compiled.NativeRep().Expr(), ast.NewExprVisitor(func(e ast.Expr) {
+		switch e.Kind() {
+		case ast.SelectKind:
+			accesses[e.ID()] = true
+		case ast.CallKind:
+			if e.AsCall().FunctionName() == operators.Index {
+				accesses[e.ID()] = true
+			}
+		}
+	}))
+	return &celExpression{expr: expr, program: program, accesses: accesses}, nil
+}
+
+func (e *celExpression) Values(obj map[string]interface{}) ([]interface{}, error) {
+	out, _, err := e.program.Eval(map[string]interface{}{"self": obj})
+	if err != nil {
+		// The errors of the field selections and indexes are those of the
+		// missing fields, as for a JSONPath expression
+		if celErr, ok := out.(*types.Err); ok && e.accesses[celErr.NodeID()] {
+			return nil, &NotFoundError{Expression: e.expr}
+		}
+		return nil, &EvalError{Expression: e.expr, Err: err}
+	}
+	if out == types.NullValue {
+		return nil, &NotFoundError{Expression: e.expr}
+	}
+	value, err := native(out)
+	if err != nil {
+		return nil, &EvalError{Expression: e.expr, Err: err}
+	}
+	return []interface{}{value}, nil
+}
+
+func (e *celExpression) String() string {
+	return e.expr
+}
+
+var (
+	listType = reflect.TypeOf([]interface{}{})
+	mapType  = reflect.TypeOf(map[string]interface{}{})
+)
+
+// native converts a CEL value to the types of an unstructured object
+func native(v ref.Val) (interface{}, error) {
+	switch v.Type() {
+	case types.ListType:
+		return v.ConvertToNative(listType)
+	case types.MapType:
+		return v.ConvertToNative(mapType)
+	}
+	return v.Value(), nil
+}
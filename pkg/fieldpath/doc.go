@@ -0,0 +1,22 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fieldpath extracts fields from unstructured objects without
+// panicking on unexpected shapes. An expression is either a JSONPath, such
+// as "status.phase" or "status.conditions[?(@.type==\"Ready\")].status",
+// or a CEL expression prefixed by "cel:" in which the object is "self",
+// such as "cel:self.status.readyReplicas == self.spec.replicas". The cost
+// of a CEL expression is bounded by CELCostLimit.
+// Failures are reported by the typed errors of this package.
+package fieldpath
@@ -0,0 +1,204 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldpath
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// CELPrefix marks a CEL expression
+const CELPrefix = "cel:"
+
+// ParseError reports an expression which does not compile
+type ParseError struct {
+	Expression string
+	Err        error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid expression %q: %v", e.Expression, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// EvalError reports an expression which failed on an object
+type EvalError struct {
+	Expression string
+	Err        error
+}
+
+func (e *EvalError) Error() string {
+	return fmt.Sprintf("evaluating %q: %v", e.Expression, e.Err)
+}
+
+func (e *EvalError) Unwrap() error {
+	return e.Err
+}
+
+// NotFoundError reports an expression which selects nothing in an object
+type NotFoundError struct {
+	Expression string
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("%q not found", e.Expression)
+}
+
+// TypeError reports a value which is not of the expected type
+type TypeError struct {
+	Expression string
+	Expected   string
+	Value      interface{}
+}
+
+func (e *TypeError) Error() string {
+	return fmt.Sprintf("%q: expected %s, got %T", e.Expression, e.Expected, e.Value)
+}
+
+// Expression is a compiled expression
+type Expression interface {
+	// Values returns the values the expression selects in obj, or a
+	// NotFoundError if there are none
+	Values(obj map[string]interface{}) ([]interface{}, error)
+	String() string
+}
+
+// cache holds the compiled expressions, which are evaluated over and over
+// by the readiness checks
+var cache sync.Map
+
+// Compile compiles an expression, see the package documentation
+func Compile(expr string) (Expression, error) {
+	if compiled, ok := cache.Load(expr); ok {
+		return compiled.(Expression), nil
+	}
+	var compiled Expression
+	var err error
+	if strings.HasPrefix(expr, CELPrefix) {
+		compiled, err = compileCEL(expr)
+	} else {
+		compiled, err = compileJSONPath(expr)
+	}
+	if err != nil {
+		return nil, err
+	}
+	cache.Store(expr, compiled)
+	return compiled, nil
+}
+
+// String evaluates an expression which selects a single scalar and
+// returns it formatted as a string
+func String(expr string, obj map[string]interface{}) (string, error) {
+	value, err := single(expr, obj)
+	if err != nil {
+		return "", err
+	}
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int64, int32, int, uint64, float64:
+		return fmt.Sprintf("%v", v), nil
+	}
+	return "", &TypeError{Expression: expr, Expected: "a scalar", Value: value}
+}
+
+// Bool evaluates a predicate, an expression which selects a single boolean
+func Bool(expr string, obj map[string]interface{}) (bool, error) {
+	value, err := single(expr, obj)
+	if err != nil {
+		return false, err
+	}
+	b, ok := value.(bool)
+	if !ok {
+		return false, &TypeError{Expression: expr, Expected: "a boolean", Value: value}
+	}
+	return b, nil
+}
+
+func single(expr string, obj map[string]interface{}) (interface{}, error) {
+	compiled, err := Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+	values, err := compiled.Values(obj)
+	if err != nil {
+		return nil, err
+	}
+	if len(values) != 1 {
+		return nil, &TypeError{Expression: expr, Expected: "a single value", Value: values}
+	}
+	return values[0], nil
+}
+
+// jsonPathExpression holds the parsed template rather than a JSONPath: a
+// JSONPath keeps the state of its range and end nodes across evaluations,
+// and the compiled expressions are shared by the cache.
+type jsonPathExpression struct {
+	expr     string
+	template string
+}
+
+// compileJSONPath accepts the kubectl syntax, "{.status.phase}", as well
+// as the bare one, "status.phase" or "$.status.phase".
+func compileJSONPath(expr string) (Expression, error) {
+	template := strings.TrimSpace(expr)
+	if !strings.HasPrefix(template, "{") {
+		template = strings.TrimPrefix(template, "$")
+		if !strings.HasPrefix(template, ".") && !strings.HasPrefix(template, "[") {
+			template = "." + template
+		}
+		template = "{" + template + "}"
+	}
+	if _, err := jsonpath.Parse(expr, template); err != nil {
+		return nil, &ParseError{Expression: expr, Err: err}
+	}
+	return &jsonPathExpression{expr: expr, template: template}, nil
+}
+
+func (e *jsonPathExpression) Values(obj map[string]interface{}) ([]interface{}, error) {
+	path := jsonpath.New(e.expr).AllowMissingKeys(true)
+	if err := path.Parse(e.template); err != nil {
+		return nil, &ParseError{Expression: e.expr, Err: err}
+	}
+	results, err := path.FindResults(obj)
+	if err != nil {
+		return nil, &EvalError{Expression: e.expr, Err: err}
+	}
+	values := make([]interface{}, 0)
+	for _, result := range results {
+		for _, value := range result {
+			if value.IsValid() && value.CanInterface() && value.Interface() != nil {
+				values = append(values, value.Interface())
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil, &NotFoundError{Expression: e.expr}
+	}
+	return values, nil
+}
+
+func (e *jsonPathExpression) String() string {
+	return e.expr
+}
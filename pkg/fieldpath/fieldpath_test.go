@@ -0,0 +1,168 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fieldpath
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+)
+
+const object = `
+spec:
+  replicas: 3
+  name: db
+status:
+  phase: Running
+  ready: true
+  readyReplicas: 3
+  conditions:
+  - {type: Available, status: "True"}
+  - {type: Ready, status: "False", reason: Pending}
+`
+
+// costly iterates a million times, past the CELCostLimit
+const costly = "[0,1,2,3,4,5,6,7,8,9].all(a, [0,1,2,3,4,5,6,7,8,9].all(b, " +
+	"[0,1,2,3,4,5,6,7,8,9].all(c, [0,1,2,3,4,5,6,7,8,9].all(d, " +
+	"[0,1,2,3,4,5,6,7,8,9].all(e, [0,1,2,3,4,5,6,7,8,9].all(f, true))))))"
+
+func newObject(t *testing.T) map[string]interface{} {
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(object), &obj); err != nil {
+		t.Fatalf("invalid object: %v", err)
+	}
+	return obj
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		expr  string
+		value string
+		err   interface{}
+	}{
+		{"status.phase", "Running", nil},
+		{"$.status.phase", "Running", nil},
+		{"{.status.phase}", "Running", nil},
+		{".status.readyReplicas", "3", nil},
+		{"status.ready", "true", nil},
+		{`status.conditions[?(@.type=="Ready")].status`, "False", nil},
+		{"status.conditions[1].reason", "Pending", nil},
+		{"cel:self.status.phase", "Running", nil},
+		{"cel:self.status.readyReplicas == self.spec.replicas", "true", nil},
+		{"cel:self.status.conditions.filter(c, c.type == 'Ready')[0].status", "False", nil},
+		{"status.missing", "", &NotFoundError{}},
+		{"status.phase.deeper", "", &NotFoundError{}},
+		{`status.conditions[?(@.type=="Stalled")].status`, "", &NotFoundError{}},
+		{"cel:self.status.missing", "", &NotFoundError{}},
+		{"cel:self.status.phase.deeper", "", &NotFoundError{}},
+		{"cel:self.status.conditions[5].type", "", &NotFoundError{}},
+		{"cel:self.status['missing'] == 'x'", "", &NotFoundError{}},
+		{"status", "", &TypeError{}},
+		{"status.conditions[*].type", "", &TypeError{}},
+		{"status.conditions[", "", &ParseError{}},
+		{"cel:self.status.", "", &ParseError{}},
+		{"cel:self.spec.name + 1", "", &EvalError{}},
+		{"cel:int(self.spec.name)", "", &EvalError{}},
+		{"cel:" + costly, "", &EvalError{}},
+	}
+
+	obj := newObject(t)
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			value, err := String(tt.expr, obj)
+			switch tt.err.(type) {
+			case nil:
+				g.Expect(err).NotTo(gomega.HaveOccurred())
+				g.Expect(value).To(gomega.Equal(tt.value))
+			case *NotFoundError:
+				var target *NotFoundError
+				g.Expect(errors.As(err, &target)).To(gomega.BeTrue(), "%v", err)
+			case *TypeError:
+				var target *TypeError
+				g.Expect(errors.As(err, &target)).To(gomega.BeTrue(), "%v", err)
+			case *ParseError:
+				var target *ParseError
+				g.Expect(errors.As(err, &target)).To(gomega.BeTrue(), "%v", err)
+			case *EvalError:
+				var target *EvalError
+				g.Expect(errors.As(err, &target)).To(gomega.BeTrue(), "%v", err)
+			}
+		})
+	}
+}
+
+func TestBool(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	obj := newObject(t)
+
+	ready, err := Bool("status.ready", obj)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(ready).To(gomega.BeTrue())
+
+	ready, err = Bool(`cel:self.status.conditions.exists(c, c.type == "Ready" && c.status == "True")`, obj)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(ready).To(gomega.BeFalse())
+
+	_, err = Bool("status.phase", obj)
+	var typeErr *TypeError
+	g.Expect(errors.As(err, &typeErr)).To(gomega.BeTrue())
+
+	// Values of any type, lists included
+	compiled, err := Compile("status.conditions[*].type")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(compiled.Values(obj)).To(gomega.Equal([]interface{}{"Available", "Ready"}))
+	compiled, err = Compile("cel:self.status.conditions.map(c, c.type)")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(compiled.Values(obj)).To(gomega.Equal([]interface{}{[]interface{}{"Available", "Ready"}}))
+}
+
+// TestConcurrentValues evaluates a cached range expression from several
+// goroutines, as the readiness checks do; run it with -race.
+func TestConcurrentValues(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	obj := newObject(t)
+	expected := []interface{}{"Available", "Ready"}
+
+	compiled, err := Compile("{range .status.conditions[*]}{.type}{end}")
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(compiled.Values(obj)).To(gomega.Equal(expected))
+
+	var wg sync.WaitGroup
+	failures := make(chan string, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				values, err := compiled.Values(obj)
+				if err != nil || !reflect.DeepEqual(values, expected) {
+					failures <- fmt.Sprintf("got %v, %v", values, err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(failures)
+	for failure := range failures {
+		t.Error(failure)
+	}
+}
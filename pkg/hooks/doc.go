@@ -0,0 +1,20 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hooks runs the pre and post upgrade actions of an ArmadaChart.
+// Each ArmadaHookActionItems selects objects by type, and by name or labels,
+// in the namespace of the release. The delete and update actions select live
+// objects; the create actions, and the new content of the updated objects,
+// come from the rendered manifests of the release.
+package hooks
@@ -0,0 +1,265 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+// Phase is the step of the upgrade an action runs at
+type Phase string
+
+const (
+	PhasePre  Phase = "pre"
+	PhasePost Phase = "post"
+)
+
+// Operation is what an action does to the objects it selects
+type Operation string
+
+const (
+	OperationCreate Operation = "create"
+	OperationDelete Operation = "delete"
+	OperationUpdate Operation = "update"
+)
+
+// Resources maps the types of ArmadaHookActionItems to their resources
+var Resources = map[string]schema.GroupVersionResource{
+	"pod":         {Version: "v1", Resource: "pods"},
+	"service":     {Version: "v1", Resource: "services"},
+	"configmap":   {Version: "v1", Resource: "configmaps"},
+	"secret":      {Version: "v1", Resource: "secrets"},
+	"job":         {Group: "batch", Version: "v1", Resource: "jobs"},
+	"cronjob":     {Group: "batch", Version: "v1", Resource: "cronjobs"},
+	"deployment":  {Group: "apps", Version: "v1", Resource: "deployments"},
+	"daemonset":   {Group: "apps", Version: "v1", Resource: "daemonsets"},
+	"statefulset": {Group: "apps", Version: "v1", Resource: "statefulsets"},
+}
+
+// kinds maps the types to the kinds of the rendered manifests
+var kinds = map[string]schema.GroupKind{
+	"pod":         {Kind: "Pod"},
+	"service":     {Kind: "Service"},
+	"configmap":   {Kind: "ConfigMap"},
+	"secret":      {Kind: "Secret"},
+	"job":         {Group: "batch", Kind: "Job"},
+	"cronjob":     {Group: "batch", Kind: "CronJob"},
+	"deployment":  {Group: "apps", Kind: "Deployment"},
+	"daemonset":   {Group: "apps", Kind: "DaemonSet"},
+	"statefulset": {Group: "apps", Kind: "StatefulSet"},
+}
+
+// Result is the outcome of one action
+type Result struct {
+	Phase     Phase             `json:"phase"`
+	Operation Operation         `json:"operation"`
+	Type      string            `json:"type"`
+	Name      string            `json:"name,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	// Objects are the names of the objects acted upon
+	Objects []string `json:"objects,omitempty"`
+	// Skipped is set when the hooks are disabled by NoHooks
+	Skipped bool   `json:"skipped,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// Executor runs the actions of an ArmadaUpgrade
+type Executor struct {
+	client    dynamic.Interface
+	namespace string
+	manifests []unstructured.Unstructured
+}
+
+// NewExecutor returns an Executor acting in the namespace of a release.
+// The manifests are the rendered objects of the release.
+func NewExecutor(client dynamic.Interface, namespace string, manifests []unstructured.Unstructured) *Executor {
+	return &Executor{client: client, namespace: namespace, manifests: manifests}
+}
+
+// Pre runs the actions preceding the upgrade: the deletions, then the
+// updates, then the creations. It stops at the first failed action, whose
+// result holds the error.
+func (e *Executor) Pre(ctx context.Context, upgrade *av1.ArmadaUpgrade) ([]Result, error) {
+	if upgrade == nil || upgrade.Pre == nil {
+		return []Result{}, nil
+	}
+	return e.run(ctx, upgrade.NoHooks, PhasePre, []step{
+		{OperationDelete, upgrade.Pre.Delete},
+		{OperationUpdate, upgrade.Pre.Update},
+		{OperationCreate, upgrade.Pre.Create},
+	})
+}
+
+// Post runs the actions following the upgrade, see Pre
+func (e *Executor) Post(ctx context.Context, upgrade *av1.ArmadaUpgrade) ([]Result, error) {
+	if upgrade == nil || upgrade.Post == nil {
+		return []Result{}, nil
+	}
+	return e.run(ctx, upgrade.NoHooks, PhasePost, []step{
+		{OperationCreate, upgrade.Post.Create},
+	})
+}
+
+type step struct {
+	operation Operation
+	actions   []*av1.ArmadaHookActionItems
+}
+
+func (e *Executor) run(ctx context.Context, noHooks bool, phase Phase, steps []step) ([]Result, error) {
+	results := make([]Result, 0)
+	for _, s := range steps {
+		for _, action := range s.actions {
+			if action == nil {
+				continue
+			}
+			result := Result{Phase: phase, Operation: s.operation, Type: action.Type, Name: action.Name}
+			if action.Labels != nil {
+				result.Labels = *action.Labels
+			}
+			if noHooks {
+				result.Skipped = true
+				results = append(results, result)
+				continue
+			}
+			objects, err := e.execute(ctx, s.operation, action)
+			result.Objects = objects
+			if err != nil {
+				result.Error = err.Error()
+				results = append(results, result)
+				return results, fmt.Errorf("%s %s %s: %v", phase, s.operation, action.Type, err)
+			}
+			results = append(results, result)
+		}
+	}
+	return results, nil
+}
+
+func (e *Executor) execute(ctx context.Context, operation Operation, action *av1.ArmadaHookActionItems) ([]string, error) {
+	gvr, ok := Resources[action.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported type %q", action.Type)
+	}
+	selector := labels.Everything()
+	if action.Labels != nil {
+		selector = labels.SelectorFromSet(*action.Labels)
+	}
+	if operation != OperationCreate && action.Name == "" && selector.Empty() {
+		// Do not act on every object of the namespace by accident
+		return nil, fmt.Errorf("a name or labels are required")
+	}
+
+	client := e.client.Resource(gvr).Namespace(e.namespace)
+	done := make([]string, 0)
+	switch operation {
+	case OperationDelete:
+		live, err := e.resolve(ctx, client, action.Name, selector)
+		if err != nil {
+			return nil, err
+		}
+		policy := metav1.DeletePropagationBackground
+		for _, obj := range live {
+			err := client.Delete(ctx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &policy})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return done, err
+			}
+			done = append(done, obj.GetName())
+		}
+	case OperationUpdate:
+		live, err := e.resolve(ctx, client, action.Name, selector)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range live {
+			desired := e.manifest(action.Type, obj.GetName())
+			if desired == nil {
+				return done, fmt.Errorf("%s is not in the manifests of the release", obj.GetName())
+			}
+			desired.SetNamespace(e.namespace)
+			desired.SetResourceVersion(obj.GetResourceVersion())
+			if _, err := client.Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+				return done, err
+			}
+			done = append(done, obj.GetName())
+		}
+	case OperationCreate:
+		for _, obj := range e.rendered(action.Type, action.Name, selector) {
+			obj.SetNamespace(e.namespace)
+			if _, err := client.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+				return done, err
+			}
+			done = append(done, obj.GetName())
+		}
+	}
+	return done, nil
+}
+
+// resolve returns the live objects selected by name and labels
+func (e *Executor) resolve(ctx context.Context, client dynamic.ResourceInterface, name string,
+	selector labels.Selector) ([]unstructured.Unstructured, error) {
+	if name != "" {
+		obj, err := client.Get(ctx, name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !selector.Matches(labels.Set(obj.GetLabels())) {
+			return nil, nil
+		}
+		return []unstructured.Unstructured{*obj}, nil
+	}
+	list, err := client.List(ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(list.Items, func(i, j int) bool { return list.Items[i].GetName() < list.Items[j].GetName() })
+	return list.Items, nil
+}
+
+// rendered returns copies of the manifests selected by type, name and labels
+func (e *Executor) rendered(resourceType string, name string, selector labels.Selector) []*unstructured.Unstructured {
+	res := make([]*unstructured.Unstructured, 0)
+	for i := range e.manifests {
+		obj := &e.manifests[i]
+		if obj.GroupVersionKind().GroupKind() != kinds[resourceType] {
+			continue
+		}
+		if (name != "" && obj.GetName() != name) || !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+		res = append(res, obj.DeepCopy())
+	}
+	return res
+}
+
+// manifest returns a copy of the manifest of an object, nil if there is none
+func (e *Executor) manifest(resourceType string, name string) *unstructured.Unstructured {
+	objects := e.rendered(resourceType, name, labels.Everything())
+	if len(objects) == 0 {
+		return nil
+	}
+	return objects[0]
+}
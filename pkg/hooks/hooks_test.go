@@ -0,0 +1,176 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hooks
+
+import (
+	"context"
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func newObject(apiVersion string, kind string, name string, labels map[string]string, data map[string]interface{}) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	u.SetAPIVersion(apiVersion)
+	u.SetKind(kind)
+	u.SetNamespace("openstack")
+	u.SetName(name)
+	u.SetLabels(labels)
+	if data != nil {
+		u.Object["data"] = data
+	}
+	return u
+}
+
+func newClient(objects ...runtime.Object) *dynamicfake.FakeDynamicClient {
+	listKinds := make(map[schema.GroupVersionResource]string)
+	for t, gvr := range Resources {
+		listKinds[gvr] = kinds[t].Kind + "List"
+	}
+	return dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), listKinds, objects...)
+}
+
+var (
+	dbSync    = map[string]string{"application": "keystone", "component": "db-sync"}
+	bootstrap = map[string]string{"application": "keystone", "component": "bootstrap"}
+)
+
+func newUpgrade() *av1.ArmadaUpgrade {
+	return &av1.ArmadaUpgrade{
+		Pre: &av1.ArmadaUpgradePre{
+			Create: []*av1.ArmadaHookActionItems{{Type: "job", Labels: &dbSync}},
+			Delete: []*av1.ArmadaHookActionItems{{Type: "job", Labels: &dbSync}, {Type: "pod", Name: "keystone-test"}},
+			Update: []*av1.ArmadaHookActionItems{{Type: "configmap", Name: "keystone-etc"}},
+		},
+		Post: &av1.ArmadaUpgradePost{
+			Create: []*av1.ArmadaHookActionItems{{Type: "job", Labels: &bootstrap}},
+		},
+	}
+}
+
+func TestExecutor(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.TODO()
+
+	client := newClient(
+		newObject("batch/v1", "Job", "keystone-db-sync", dbSync, nil),
+		newObject("batch/v1", "Job", "keystone-bootstrap", bootstrap, nil),
+		newObject("v1", "ConfigMap", "keystone-etc", nil, map[string]interface{}{"debug": "false"}),
+		newObject("v1", "ConfigMap", "keystone-bin", nil, nil),
+	)
+	manifests := []unstructured.Unstructured{
+		*newObject("batch/v1", "Job", "keystone-db-sync", dbSync, nil),
+		*newObject("batch/v1", "Job", "keystone-bootstrap-2", bootstrap, nil),
+		*newObject("v1", "ConfigMap", "keystone-etc", nil, map[string]interface{}{"debug": "true"}),
+	}
+	executor := NewExecutor(client, "openstack", manifests)
+
+	results, err := executor.Pre(ctx, newUpgrade())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(results).To(gomega.HaveLen(4))
+	g.Expect(results[0].Operation).To(gomega.Equal(OperationDelete))
+	g.Expect(results[0].Objects).To(gomega.Equal([]string{"keystone-db-sync"}))
+	// A missing object is not an error
+	g.Expect(results[1].Objects).To(gomega.BeEmpty())
+	g.Expect(results[2].Operation).To(gomega.Equal(OperationUpdate))
+	g.Expect(results[2].Objects).To(gomega.Equal([]string{"keystone-etc"}))
+	g.Expect(results[3].Operation).To(gomega.Equal(OperationCreate))
+	g.Expect(results[3].Objects).To(gomega.Equal([]string{"keystone-db-sync"}))
+
+	// The deletions come first
+	verbs := make([]string, 0)
+	for _, action := range client.Actions() {
+		if action.GetVerb() != "get" && action.GetVerb() != "list" {
+			verbs = append(verbs, action.GetVerb()+" "+action.GetResource().Resource)
+		}
+	}
+	g.Expect(verbs).To(gomega.Equal([]string{"delete jobs", "update configmaps", "create jobs"}))
+
+	etc, err := client.Resource(Resources["configmap"]).Namespace("openstack").Get(ctx, "keystone-etc", metav1.GetOptions{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(etc.Object["data"]).To(gomega.Equal(map[string]interface{}{"debug": "true"}))
+
+	results, err = executor.Post(ctx, newUpgrade())
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(results).To(gomega.HaveLen(1))
+	g.Expect(results[0].Phase).To(gomega.Equal(PhasePost))
+	g.Expect(results[0].Objects).To(gomega.Equal([]string{"keystone-bootstrap-2"}))
+}
+
+func TestExecutorNoHooks(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	client := newClient(newObject("batch/v1", "Job", "keystone-db-sync", dbSync, nil))
+	executor := NewExecutor(client, "openstack", nil)
+	upgrade := newUpgrade()
+	upgrade.NoHooks = true
+
+	results, err := executor.Pre(context.TODO(), upgrade)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(results).To(gomega.HaveLen(4))
+	for _, result := range results {
+		g.Expect(result.Skipped).To(gomega.BeTrue())
+	}
+	g.Expect(client.Actions()).To(gomega.BeEmpty())
+
+	results, err = executor.Pre(context.TODO(), &av1.ArmadaUpgrade{})
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(results).To(gomega.BeEmpty())
+}
+
+func TestExecutorErrors(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.TODO()
+
+	client := newClient(
+		newObject("batch/v1", "Job", "keystone-db-sync", dbSync, nil),
+		newObject("v1", "ConfigMap", "keystone-etc", nil, nil),
+	)
+	executor := NewExecutor(client, "openstack", nil)
+
+	// The first failed action stops the phase
+	upgrade := &av1.ArmadaUpgrade{Pre: &av1.ArmadaUpgradePre{
+		Delete: []*av1.ArmadaHookActionItems{{Type: "replicaset", Name: "keystone"}, {Type: "job", Labels: &dbSync}},
+	}}
+	results, err := executor.Pre(ctx, upgrade)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(results).To(gomega.HaveLen(1))
+	g.Expect(results[0].Error).To(gomega.ContainSubstring("unsupported type"))
+
+	// Deleting every job of the namespace must be explicit
+	upgrade.Pre.Delete = []*av1.ArmadaHookActionItems{{Type: "job"}}
+	_, err = executor.Pre(ctx, upgrade)
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	// Updated objects must be rendered by the release
+	upgrade.Pre.Delete = nil
+	upgrade.Pre.Update = []*av1.ArmadaHookActionItems{{Type: "configmap", Name: "keystone-etc"}}
+	results, err = executor.Pre(ctx, upgrade)
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(results[0].Error).To(gomega.ContainSubstring("not in the manifests"))
+
+	// The created objects must not exist
+	executor = NewExecutor(client, "openstack", []unstructured.Unstructured{*newObject("batch/v1", "Job", "keystone-db-sync", dbSync, nil)})
+	upgrade.Pre.Update = nil
+	upgrade.Pre.Create = []*av1.ArmadaHookActionItems{{Type: "job", Name: "keystone-db-sync"}}
+	_, err = executor.Pre(ctx, upgrade)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
@@ -0,0 +1,296 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+)
+
+// ProblemType identifies the kind of inconsistency found by Check
+type ProblemType string
+
+// Problems reported by Check
+const (
+	// A name listed in chart_groups, chart_group or dependencies does not
+	// match any object of the expected kind in the same namespace.
+	ProblemDanglingReference ProblemType = "DanglingReference"
+	// An ArmadaChart is listed by more than one ArmadaChartGroup
+	ProblemSharedChart ProblemType = "SharedChart"
+	// An ArmadaChartGroup is not listed by any ArmadaManifest
+	ProblemUnreachableGroup ProblemType = "UnreachableGroup"
+	// The dependencies of a set of ArmadaCharts loop
+	ProblemDependencyCycle ProblemType = "DependencyCycle"
+)
+
+// Problem is a single inconsistency between the armada objects
+type Problem struct {
+	Type ProblemType `json:"type"`
+	// Namespace, Kind and Name identify the object the problem is
+	// attached to.
+	Namespace string `json:"namespace,omitempty"`
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	// Field is the spec field holding the faulty reference, if any
+	Field string `json:"field,omitempty"`
+	// References lists the names involved: the unresolved name, the
+	// groups sharing the chart or the charts of the cycle in order.
+	References []string `json:"references,omitempty"`
+	Message    string   `json:"message"`
+}
+
+// String converts a Problem to a printable string
+func (p Problem) String() string {
+	name := p.Name
+	if p.Namespace != "" {
+		name = p.Namespace + "/" + p.Name
+	}
+	if p.Field != "" {
+		return fmt.Sprintf("%s %s: %s: %s", p.Kind, name, p.Field, p.Message)
+	}
+	return fmt.Sprintf("%s %s: %s", p.Kind, name, p.Message)
+}
+
+// Report is the result of Check
+type Report struct {
+	Problems []Problem `json:"problems"`
+}
+
+// OK returns true if no problem has been found
+func (r *Report) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// Check verifies that the references between the manifests, the chart
+// groups and the charts resolve. References are resolved within the
+// namespace of the referencing object. The problems are sorted by
+// namespace, kind and name.
+func Check(manifests []av1.ArmadaManifest, groups []av1.ArmadaChartGroup, charts []av1.ArmadaChart) *Report {
+	report := &Report{Problems: make([]Problem, 0)}
+
+	groupsByKey := make(map[string]*av1.ArmadaChartGroup)
+	for i := range groups {
+		groupsByKey[key(groups[i].Namespace, groups[i].Name)] = &groups[i]
+	}
+	chartsByKey := make(map[string]*av1.ArmadaChart)
+	for i := range charts {
+		chartsByKey[key(charts[i].Namespace, charts[i].Name)] = &charts[i]
+	}
+
+	// Manifests: dangling groups and reachability
+	reachable := make(map[string]bool)
+	for _, manifest := range manifests {
+		for i, name := range manifest.Spec.ChartGroups {
+			k := key(manifest.Namespace, name)
+			if _, ok := groupsByKey[k]; !ok {
+				report.add(Problem{
+					Type:       ProblemDanglingReference,
+					Namespace:  manifest.Namespace,
+					Kind:       "ArmadaManifest",
+					Name:       manifest.Name,
+					Field:      fmt.Sprintf("spec.chart_groups[%d]", i),
+					References: []string{name},
+					Message:    fmt.Sprintf("ArmadaChartGroup %q not found", name),
+				})
+				continue
+			}
+			reachable[k] = true
+		}
+	}
+
+	// Groups: dangling charts, unreachable groups and charts shared
+	owners := make(map[string][]string)
+	for _, group := range groups {
+		if !reachable[key(group.Namespace, group.Name)] {
+			report.add(Problem{
+				Type:      ProblemUnreachableGroup,
+				Namespace: group.Namespace,
+				Kind:      "ArmadaChartGroup",
+				Name:      group.Name,
+				Message:   "not listed by any ArmadaManifest",
+			})
+		}
+		for i, name := range group.Spec.Charts {
+			k := key(group.Namespace, name)
+			if _, ok := chartsByKey[k]; !ok {
+				report.add(Problem{
+					Type:       ProblemDanglingReference,
+					Namespace:  group.Namespace,
+					Kind:       "ArmadaChartGroup",
+					Name:       group.Name,
+					Field:      fmt.Sprintf("spec.chart_group[%d]", i),
+					References: []string{name},
+					Message:    fmt.Sprintf("ArmadaChart %q not found", name),
+				})
+				continue
+			}
+			if !slices.Contains(owners[k], group.Name) {
+				owners[k] = append(owners[k], group.Name)
+			}
+		}
+	}
+	for k, groupNames := range owners {
+		if len(groupNames) < 2 {
+			continue
+		}
+		chart := chartsByKey[k]
+		sort.Strings(groupNames)
+		report.add(Problem{
+			Type:       ProblemSharedChart,
+			Namespace:  chart.Namespace,
+			Kind:       "ArmadaChart",
+			Name:       chart.Name,
+			References: groupNames,
+			Message:    "listed by ArmadaChartGroups " + strings.Join(groupNames, ", "),
+		})
+	}
+
+	// Charts: dangling dependencies and cycles
+	for _, chart := range charts {
+		for i, name := range chart.Spec.Dependencies {
+			if _, ok := chartsByKey[key(chart.Namespace, name)]; !ok {
+				report.add(Problem{
+					Type:       ProblemDanglingReference,
+					Namespace:  chart.Namespace,
+					Kind:       "ArmadaChart",
+					Name:       chart.Name,
+					Field:      fmt.Sprintf("spec.dependencies[%d]", i),
+					References: []string{name},
+					Message:    fmt.Sprintf("ArmadaChart %q not found", name),
+				})
+			}
+		}
+	}
+	for _, cycle := range dependencyCycles(chartsByKey) {
+		first := chartsByKey[cycle[0]]
+		names := make([]string, 0, len(cycle))
+		for _, k := range cycle {
+			names = append(names, chartsByKey[k].Name)
+		}
+		report.add(Problem{
+			Type:       ProblemDependencyCycle,
+			Namespace:  first.Namespace,
+			Kind:       "ArmadaChart",
+			Name:       first.Name,
+			Field:      "spec.dependencies",
+			References: names,
+			Message:    "dependency cycle " + strings.Join(append(names, names[0]), " -> "),
+		})
+	}
+
+	sort.SliceStable(report.Problems, func(i, j int) bool {
+		a, b := report.Problems[i], report.Problems[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		if a.Kind != b.Kind {
+			return kindOrder[a.Kind] < kindOrder[b.Kind]
+		}
+		return a.Name < b.Name
+	})
+	return report
+}
+
+// kindOrder sorts the problems top-down: manifests, groups then charts
+var kindOrder = map[string]int{
+	"ArmadaManifest":   0,
+	"ArmadaChartGroup": 1,
+	"ArmadaChart":      2,
+}
+
+func (r *Report) add(p Problem) {
+	r.Problems = append(r.Problems, p)
+}
+
+// dependencyCycles returns one cycle per back edge found by a depth first
+// walk of the dependencies, not every elementary cycle of the graph. Each
+// cycle is returned once, as a list of chart keys starting with the
+// smallest one. A chart depending on itself is a cycle of length one.
+func dependencyCycles(chartsByKey map[string]*av1.ArmadaChart) [][]string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int)
+	stack := make([]string, 0)
+	seen := make(map[string]bool)
+	cycles := make([][]string, 0)
+
+	var visit func(k string)
+	visit = func(k string) {
+		state[k] = inProgress
+		stack = append(stack, k)
+
+		chart := chartsByKey[k]
+		for _, dep := range chart.Spec.Dependencies {
+			depKey := key(chart.Namespace, dep)
+			if _, ok := chartsByKey[depKey]; !ok {
+				continue
+			}
+			switch state[depKey] {
+			case unvisited:
+				visit(depKey)
+			case inProgress:
+				start := len(stack) - 1
+				for stack[start] != depKey {
+					start--
+				}
+				cycle := normalize(stack[start:])
+				id := strings.Join(cycle, "\x00")
+				if !seen[id] {
+					seen[id] = true
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		stack = stack[:len(stack)-1]
+		state[k] = done
+	}
+
+	keys := make([]string, 0, len(chartsByKey))
+	for k := range chartsByKey {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if state[k] == unvisited {
+			visit(k)
+		}
+	}
+	return cycles
+}
+
+// normalize rotates a cycle so that it starts with its smallest element
+func normalize(cycle []string) []string {
+	smallest := 0
+	for i := range cycle {
+		if cycle[i] < cycle[smallest] {
+			smallest = i
+		}
+	}
+	res := make([]string, 0, len(cycle))
+	res = append(res, cycle[smallest:]...)
+	return append(res, cycle[:smallest]...)
+}
+
+func key(namespace string, name string) string {
+	return namespace + "/" + name
+}
@@ -0,0 +1,98 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integrity
+
+import (
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func manifest(name string, groups ...string) av1.ArmadaManifest {
+	return av1.ArmadaManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       av1.ArmadaManifestSpec{ChartGroups: groups},
+	}
+}
+
+func group(name string, charts ...string) av1.ArmadaChartGroup {
+	return av1.ArmadaChartGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       av1.ArmadaChartGroupSpec{Charts: charts},
+	}
+}
+
+func chart(name string, deps ...string) av1.ArmadaChart {
+	return av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       av1.ArmadaChartSpec{Dependencies: deps},
+	}
+}
+
+func TestCheckConsistent(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	report := Check(
+		[]av1.ArmadaManifest{manifest("site", "infra", "openstack")},
+		[]av1.ArmadaChartGroup{group("infra", "mariadb"), group("openstack", "keystone")},
+		[]av1.ArmadaChart{chart("helm-toolkit"), chart("mariadb", "helm-toolkit"), chart("keystone", "helm-toolkit", "mariadb")},
+	)
+	g.Expect(report.OK()).To(gomega.BeTrue())
+	g.Expect(report.Problems).To(gomega.BeEmpty())
+}
+
+func TestCheckProblems(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	report := Check(
+		[]av1.ArmadaManifest{manifest("site", "infra", "missing-group")},
+		[]av1.ArmadaChartGroup{group("infra", "mariadb", "missing-chart"), group("orphan", "mariadb")},
+		[]av1.ArmadaChart{chart("mariadb", "missing-dep"), chart("a", "b"), chart("b", "c"), chart("c", "a"), chart("self", "self")},
+	)
+	g.Expect(report.OK()).To(gomega.BeFalse())
+
+	found := make([]string, 0)
+	for _, p := range report.Problems {
+		found = append(found, string(p.Type)+" "+p.String())
+	}
+	g.Expect(found).To(gomega.Equal([]string{
+		`DanglingReference ArmadaManifest site: spec.chart_groups[1]: ArmadaChartGroup "missing-group" not found`,
+		`DanglingReference ArmadaChartGroup infra: spec.chart_group[1]: ArmadaChart "missing-chart" not found`,
+		`UnreachableGroup ArmadaChartGroup orphan: not listed by any ArmadaManifest`,
+		`DependencyCycle ArmadaChart a: spec.dependencies: dependency cycle a -> b -> c -> a`,
+		`SharedChart ArmadaChart mariadb: listed by ArmadaChartGroups infra, orphan`,
+		`DanglingReference ArmadaChart mariadb: spec.dependencies[0]: ArmadaChart "missing-dep" not found`,
+		`DependencyCycle ArmadaChart self: spec.dependencies: dependency cycle self -> self`,
+	}))
+}
+
+func TestCheckNamespaces(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	m := manifest("site", "infra")
+	m.Namespace = "ns1"
+	grp := group("infra", "mariadb")
+	grp.Namespace = "ns2"
+	c := chart("mariadb")
+	c.Namespace = "ns2"
+
+	report := Check([]av1.ArmadaManifest{m}, []av1.ArmadaChartGroup{grp}, []av1.ArmadaChart{c})
+	g.Expect(report.Problems).To(gomega.HaveLen(2))
+	g.Expect(report.Problems[0].Type).To(gomega.Equal(ProblemDanglingReference))
+	g.Expect(report.Problems[0].Namespace).To(gomega.Equal("ns1"))
+	g.Expect(report.Problems[1].Type).To(gomega.Equal(ProblemUnreachableGroup))
+}
@@ -0,0 +1,18 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package legacy converts between the multi-document YAML bundles consumed
+// by the Python Armada CLI (armada/Chart/v1, armada/ChartGroup/v1 and
+// armada/Manifest/v1) and the armada.airshipit.org/v1alpha1 custom resources.
+package legacy
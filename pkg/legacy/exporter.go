@@ -0,0 +1,193 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legacy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	yamlv2 "gopkg.in/yaml.v2"
+)
+
+// crdOnlyFields lists the spec fields which only exist in the custom
+// resources and have no equivalent in the legacy documents.
+var crdOnlyFields = []string{
+	"target_state",
+	"revisionHistoryLimit",
+	"library",
+}
+
+// Export converts an ArmadaManifest, the ArmadaChartGroups it names and the
+// ArmadaCharts those groups reference into legacy Armada documents. The
+// charts listed in the dependencies of the exported charts are exported too.
+// Groups and charts which are not reachable from the manifest are ignored.
+// The documents are returned charts first, then groups and the manifest.
+func Export(manifest *av1.ArmadaManifest, groups []av1.ArmadaChartGroup, charts []av1.ArmadaChart) ([]Document, error) {
+	if manifest == nil {
+		return nil, fmt.Errorf("no ArmadaManifest to export")
+	}
+
+	groupsByName := make(map[string]*av1.ArmadaChartGroup)
+	for i := range groups {
+		groupsByName[groups[i].Name] = &groups[i]
+	}
+	chartsByName := make(map[string]*av1.ArmadaChart)
+	for i := range charts {
+		chartsByName[charts[i].Name] = &charts[i]
+	}
+
+	missing := make([]string, 0)
+	chartDocs := make([]Document, 0)
+	groupDocs := make([]Document, 0)
+	exported := make(map[string]bool)
+
+	// Depth first so that the charts are listed in the order of the groups,
+	// each of them followed by the dependencies not exported yet.
+	var exportChart func(name string) error
+	exportChart = func(name string) error {
+		if exported[name] {
+			return nil
+		}
+		chart, ok := chartsByName[name]
+		if !ok {
+			missing = append(missing, "ArmadaChart "+name)
+			exported[name] = true
+			return nil
+		}
+		exported[name] = true
+		doc, err := newDocument(SchemaChart, chart.Name, chart.Spec)
+		if err != nil {
+			return err
+		}
+		chartDocs = append(chartDocs, doc)
+		for _, dep := range chart.Spec.Dependencies {
+			if err := exportChart(dep); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, groupName := range manifest.Spec.ChartGroups {
+		group, ok := groupsByName[groupName]
+		if !ok {
+			missing = append(missing, "ArmadaChartGroup "+groupName)
+			continue
+		}
+		doc, err := newDocument(SchemaChartGroup, group.Name, group.Spec)
+		if err != nil {
+			return nil, err
+		}
+		groupDocs = append(groupDocs, doc)
+		for _, chartName := range group.Spec.Charts {
+			if err := exportChart(chartName); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return nil, fmt.Errorf("ArmadaManifest %s references unknown objects: %s",
+			manifest.Name, strings.Join(missing, ", "))
+	}
+
+	manifestDoc, err := newDocument(SchemaManifest, manifest.Name, manifest.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := append(chartDocs, groupDocs...)
+	return append(docs, manifestDoc), nil
+}
+
+// Export converts the ArmadaManifest of the Bundle called name, and the
+// objects it references, into legacy Armada documents.
+func (b *Bundle) Export(name string) ([]Document, error) {
+	for i := range b.Manifests {
+		if b.Manifests[i].Name == name {
+			return Export(&b.Manifests[i], b.ChartGroups, b.Charts)
+		}
+	}
+	return nil, fmt.Errorf("ArmadaManifest %s not found", name)
+}
+
+// WriteDocuments writes legacy documents as a multi-document YAML stream
+func WriteDocuments(w io.Writer, docs []Document) error {
+	for _, doc := range docs {
+		var data interface{}
+		if len(doc.Data) > 0 {
+			if err := yamlv2.Unmarshal(doc.Data, &data); err != nil {
+				return err
+			}
+		}
+
+		out := yamlv2.MapSlice{
+			{Key: "schema", Value: doc.Schema},
+			{Key: "metadata", Value: yamlv2.MapSlice{
+				{Key: "schema", Value: doc.Metadata.Schema},
+				{Key: "name", Value: doc.Metadata.Name},
+			}},
+			{Key: "data", Value: data},
+		}
+		blob, err := yamlv2.Marshal(out)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, "---\n"); err != nil {
+			return err
+		}
+		if _, err := w.Write(blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newDocument builds the legacy document for a spec, dropping the fields
+// the legacy schemas do not know about.
+func newDocument(schema string, name string, spec interface{}) (Document, error) {
+	blob, err := json.Marshal(spec)
+	if err != nil {
+		return Document{}, err
+	}
+
+	// UseNumber keeps the integers away from float64
+	data := make(map[string]interface{})
+	decoder := json.NewDecoder(bytes.NewReader(blob))
+	decoder.UseNumber()
+	if err := decoder.Decode(&data); err != nil {
+		return Document{}, err
+	}
+	for _, field := range crdOnlyFields {
+		delete(data, field)
+	}
+
+	blob, err = json.Marshal(data)
+	if err != nil {
+		return Document{}, err
+	}
+
+	return Document{
+		Schema:   schema,
+		Metadata: DocumentMetadata{Schema: SchemaMetadata, Name: name},
+		Data:     blob,
+	}, nil
+}
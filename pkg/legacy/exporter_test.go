@@ -0,0 +1,97 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legacy
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestExportRoundTrip(t *testing.T) {
+	opts := ImportOptions{Namespace: "armada", TargetState: av1.StateDeployed}
+	imported, _, err := Import(strings.NewReader(simpleBundle), opts)
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	docs, err := imported.Export("simple-armada")
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteDocuments(&buf, docs); err != nil {
+		t.Fatalf("WriteDocuments failed: %v", err)
+	}
+
+	reimported, issues, err := Import(&buf, opts)
+	if err != nil {
+		t.Fatalf("Import of the exported bundle failed: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("Unexpected issues on the exported bundle: %v", issues)
+	}
+	if !reflect.DeepEqual(imported, reimported) {
+		t.Errorf("Round trip changed the bundle:\n%+v\n%+v", imported, reimported)
+	}
+}
+
+func TestExportDependencies(t *testing.T) {
+	manifest := &av1.ArmadaManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "site"},
+		Spec:       av1.ArmadaManifestSpec{ChartGroups: []string{"infra"}, ReleasePrefix: "site"},
+	}
+	groups := []av1.ArmadaChartGroup{
+		{ObjectMeta: metav1.ObjectMeta{Name: "infra"}, Spec: av1.ArmadaChartGroupSpec{Charts: []string{"mariadb"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "unused"}, Spec: av1.ArmadaChartGroupSpec{Charts: []string{"unused"}}},
+	}
+	charts := []av1.ArmadaChart{
+		{ObjectMeta: metav1.ObjectMeta{Name: "mariadb"}, Spec: av1.ArmadaChartSpec{ChartName: "mariadb", Dependencies: []string{"helm-toolkit"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "helm-toolkit"}, Spec: av1.ArmadaChartSpec{ChartName: "helm-toolkit", Dependencies: []string{}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "unused"}, Spec: av1.ArmadaChartSpec{ChartName: "unused"}},
+	}
+
+	docs, err := Export(manifest, groups, charts)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	expected := []string{
+		SchemaChart + "/mariadb",
+		SchemaChart + "/helm-toolkit",
+		SchemaChartGroup + "/infra",
+		SchemaManifest + "/site",
+	}
+	actual := make([]string, 0)
+	for _, doc := range docs {
+		actual = append(actual, doc.Schema+"/"+doc.Metadata.Name)
+		if strings.Contains(string(doc.Data), "target_state") {
+			t.Errorf("target_state leaked into %s %s", doc.Schema, doc.Metadata.Name)
+		}
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Expected documents %v, got %v", expected, actual)
+	}
+
+	// Remove the dependency: the export must now fail
+	_, err = Export(manifest, groups, charts[:1])
+	if err == nil || !strings.Contains(err.Error(), "ArmadaChart helm-toolkit") {
+		t.Errorf("Expected a missing helm-toolkit error, got %v", err)
+	}
+}
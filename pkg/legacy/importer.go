@@ -0,0 +1,261 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legacy
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	kjson "sigs.k8s.io/json"
+	yaml "sigs.k8s.io/yaml"
+)
+
+// Schemas of the legacy Armada documents
+const (
+	SchemaChart      = "armada/Chart/v1"
+	SchemaChartGroup = "armada/ChartGroup/v1"
+	SchemaManifest   = "armada/Manifest/v1"
+
+	// SchemaMetadata is the schema of the metadata section of each document
+	SchemaMetadata = "metadata/Document/v1"
+)
+
+// DocumentMetadata is the metadata section of a legacy Armada document.
+// Deckhand specific keys (layeringDefinition, storagePolicy...) are ignored.
+type DocumentMetadata struct {
+	Schema string `json:"schema"`
+	Name   string `json:"name"`
+}
+
+// Document is a single document of a legacy Armada bundle
+type Document struct {
+	Schema   string           `json:"schema"`
+	Metadata DocumentMetadata `json:"metadata"`
+	// Data is the body of the document in JSON form
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+// Bundle is the set of armada custom resources equivalent to a legacy
+// Armada bundle. The order of the documents is preserved.
+type Bundle struct {
+	Charts      []av1.ArmadaChart
+	ChartGroups []av1.ArmadaChartGroup
+	Manifests   []av1.ArmadaManifest
+}
+
+// Issue describes a part of a legacy document which could not be
+// represented by the typed armada structs and has been dropped.
+type Issue struct {
+	// Schema and Name identify the document
+	Schema string
+	Name   string
+	// Field is the path of the field within the data section of the document.
+	// It is empty when the whole document has been skipped.
+	Field   string
+	Message string
+}
+
+// String converts an Issue to a printable string
+func (i Issue) String() string {
+	if i.Field == "" {
+		return fmt.Sprintf("%s %s: %s", i.Schema, i.Name, i.Message)
+	}
+	return fmt.Sprintf("%s %s: data.%s: %s", i.Schema, i.Name, i.Field, i.Message)
+}
+
+// ImportOptions tunes the objects created by Import
+type ImportOptions struct {
+	// Namespace of the created objects. Left empty if not specified.
+	Namespace string
+	// TargetState of the created objects. The legacy documents have
+	// no equivalent; StateDeployed is used if not specified.
+	TargetState av1.HelmResourceState
+}
+
+// Import reads a multi-document legacy Armada bundle and converts the
+// Chart, ChartGroup and Manifest documents it contains into their custom
+// resource equivalents. The returned issues list every field the typed
+// structs could not represent, as well as the documents which were skipped.
+func Import(r io.Reader, opts ImportOptions) (*Bundle, []Issue, error) {
+	if opts.TargetState == "" {
+		opts.TargetState = av1.StateDeployed
+	}
+
+	docs, err := ReadDocuments(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bundle := &Bundle{
+		Charts:      make([]av1.ArmadaChart, 0),
+		ChartGroups: make([]av1.ArmadaChartGroup, 0),
+		Manifests:   make([]av1.ArmadaManifest, 0),
+	}
+	issues := make([]Issue, 0)
+	seen := make(map[string]bool)
+
+	for _, doc := range docs {
+		key := doc.Schema + "/" + doc.Metadata.Name
+		switch doc.Schema {
+		case SchemaChart, SchemaChartGroup, SchemaManifest:
+			if doc.Metadata.Name == "" {
+				return nil, nil, fmt.Errorf("%s document without metadata.name", doc.Schema)
+			}
+			if seen[key] {
+				return nil, nil, fmt.Errorf("duplicate %s document %q", doc.Schema, doc.Metadata.Name)
+			}
+			seen[key] = true
+		default:
+			issues = append(issues, Issue{
+				Schema:  doc.Schema,
+				Name:    doc.Metadata.Name,
+				Message: "unsupported schema, document skipped",
+			})
+			continue
+		}
+
+		meta := metav1.ObjectMeta{Name: doc.Metadata.Name, Namespace: opts.Namespace}
+
+		switch doc.Schema {
+		case SchemaChart:
+			obj := av1.ArmadaChart{
+				TypeMeta:   metav1.TypeMeta{APIVersion: av1.SchemeGroupVersion.String(), Kind: "ArmadaChart"},
+				ObjectMeta: meta,
+			}
+			issues = append(issues, decodeData(doc, &obj.Spec)...)
+			if obj.Spec.Dependencies == nil {
+				obj.Spec.Dependencies = make([]string, 0)
+			}
+			obj.Spec.TargetState = opts.TargetState
+			bundle.Charts = append(bundle.Charts, obj)
+		case SchemaChartGroup:
+			obj := av1.ArmadaChartGroup{
+				TypeMeta:   metav1.TypeMeta{APIVersion: av1.SchemeGroupVersion.String(), Kind: "ArmadaChartGroup"},
+				ObjectMeta: meta,
+			}
+			issues = append(issues, decodeData(doc, &obj.Spec)...)
+			if obj.Spec.Charts == nil {
+				obj.Spec.Charts = make([]string, 0)
+			}
+			obj.Spec.TargetState = opts.TargetState
+			bundle.ChartGroups = append(bundle.ChartGroups, obj)
+		case SchemaManifest:
+			obj := av1.ArmadaManifest{
+				TypeMeta:   metav1.TypeMeta{APIVersion: av1.SchemeGroupVersion.String(), Kind: "ArmadaManifest"},
+				ObjectMeta: meta,
+			}
+			issues = append(issues, decodeData(doc, &obj.Spec)...)
+			if obj.Spec.ChartGroups == nil {
+				obj.Spec.ChartGroups = make([]string, 0)
+			}
+			obj.Spec.TargetState = opts.TargetState
+			bundle.Manifests = append(bundle.Manifests, obj)
+		}
+	}
+
+	return bundle, issues, nil
+}
+
+// ReadDocuments splits a multi-document YAML stream into legacy documents.
+// Empty documents are ignored.
+func ReadDocuments(r io.Reader) ([]Document, error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	docs := make([]Document, 0)
+
+	for {
+		raw, err := reader.Read()
+		if err == io.EOF {
+			return docs, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if strings.TrimSpace(string(raw)) == "" {
+			continue
+		}
+
+		blob, err := yaml.YAMLToJSON(raw)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %v", len(docs)+1, err)
+		}
+		if string(blob) == "null" {
+			continue
+		}
+
+		var doc Document
+		if err := json.Unmarshal(blob, &doc); err != nil {
+			return nil, fmt.Errorf("document %d: %v", len(docs)+1, err)
+		}
+		docs = append(docs, doc)
+	}
+}
+
+// decodeData decodes the data section of a document into the spec and
+// reports the fields which could not be represented.
+func decodeData(doc Document, spec interface{}) []Issue {
+	if len(doc.Data) == 0 {
+		return nil
+	}
+
+	issues := make([]Issue, 0)
+	strictErrs, err := kjson.UnmarshalStrict(doc.Data, spec, kjson.DisallowUnknownFields)
+	if err != nil {
+		// The data went through YAMLToJSON, so this can only be a type
+		// mismatch. The decoder skips the offending field, carries on and
+		// reports the first mismatch only.
+		issues = append(issues, Issue{
+			Schema:  doc.Schema,
+			Name:    doc.Metadata.Name,
+			Message: err.Error(),
+		})
+	}
+	for _, strictErr := range strictErrs {
+		issue := Issue{
+			Schema:  doc.Schema,
+			Name:    doc.Metadata.Name,
+			Message: "field not supported, dropped",
+		}
+		if fieldErr, ok := strictErr.(kjson.FieldError); ok {
+			issue.Field = fieldErr.FieldPath()
+		} else {
+			issue.Message = strictErr.Error()
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// Objects returns the content of the Bundle as a list of runtime.Object,
+// charts first, then chart groups and manifests.
+func (b *Bundle) Objects() []runtime.Object {
+	res := make([]runtime.Object, 0, len(b.Charts)+len(b.ChartGroups)+len(b.Manifests))
+	for i := range b.Charts {
+		res = append(res, &b.Charts[i])
+	}
+	for i := range b.ChartGroups {
+		res = append(res, &b.ChartGroups[i])
+	}
+	for i := range b.Manifests {
+		res = append(res, &b.Manifests[i])
+	}
+	return res
+}
@@ -0,0 +1,146 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package legacy
+
+import (
+	"strings"
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+)
+
+const simpleBundle = `
+---
+schema: armada/Chart/v1
+metadata:
+  schema: metadata/Document/v1
+  name: blog-1
+data:
+  chart_name: blog-1
+  release: blog-1
+  namespace: default
+  install:
+    no_hooks: false
+  upgrade:
+    no_hooks: false
+  wait:
+    timeout: 100
+  values:
+    images:
+      tags:
+        blog: docker.io/blog:latest
+    custom_key: kept
+  source:
+    type: git
+    location: https://github.com/namespace/repo
+    subpath: .
+    reference: master
+  dependencies: []
+---
+schema: armada/ChartGroup/v1
+metadata:
+  schema: metadata/Document/v1
+  name: blog-group
+data:
+  description: Deploys Simple Service
+  sequenced: true
+  chart_group:
+    - blog-1
+---
+schema: armada/Manifest/v1
+metadata:
+  schema: metadata/Document/v1
+  name: simple-armada
+data:
+  release_prefix: armada
+  chart_groups:
+    - blog-group
+---
+schema: deckhand/LayeringPolicy/v1
+metadata:
+  schema: metadata/Control/v1
+  name: layering-policy
+data:
+  layerOrder:
+    - global
+`
+
+func TestImport(t *testing.T) {
+	bundle, issues, err := Import(strings.NewReader(simpleBundle), ImportOptions{Namespace: "armada"})
+	if err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+
+	if len(bundle.Charts) != 1 || len(bundle.ChartGroups) != 1 || len(bundle.Manifests) != 1 {
+		t.Fatalf("Expected 1 chart, 1 group and 1 manifest, got %d, %d and %d",
+			len(bundle.Charts), len(bundle.ChartGroups), len(bundle.Manifests))
+	}
+
+	chart := bundle.Charts[0]
+	if chart.Name != "blog-1" || chart.Namespace != "armada" || chart.Kind != "ArmadaChart" {
+		t.Errorf("Unexpected chart metadata %s/%s kind %s", chart.Namespace, chart.Name, chart.Kind)
+	}
+	if chart.Spec.Source == nil || chart.Spec.Source.Type != "git" || chart.Spec.Wait == nil || chart.Spec.Wait.Timeout != 100 {
+		t.Errorf("Chart spec not decoded: %+v", chart.Spec)
+	}
+	if chart.Spec.Values == nil || chart.Spec.Values.Images.Tags["blog"] != "docker.io/blog:latest" {
+		t.Errorf("Chart values not decoded: %+v", chart.Spec.Values)
+	} else if string(chart.Spec.Values.Unknown["custom_key"].Raw) != `"kept"` {
+		t.Errorf("Chart values not preserved: %+v", chart.Spec.Values.Unknown)
+	}
+	if chart.Spec.TargetState != av1.StateDeployed {
+		t.Errorf("Expected target state %s, got %s", av1.StateDeployed, chart.Spec.TargetState)
+	}
+
+	group := bundle.ChartGroups[0]
+	if len(group.Spec.Charts) != 1 || group.Spec.Charts[0] != "blog-1" || !group.Spec.Sequenced {
+		t.Errorf("Unexpected chart group spec %+v", group.Spec)
+	}
+
+	manifest := bundle.Manifests[0]
+	if manifest.Spec.ReleasePrefix != "armada" || len(manifest.Spec.ChartGroups) != 1 {
+		t.Errorf("Unexpected manifest spec %+v", manifest.Spec)
+	}
+
+	expected := map[string]bool{
+		"armada/Chart/v1 blog-1: data.install: field not supported, dropped":               true,
+		"deckhand/LayeringPolicy/v1 layering-policy: unsupported schema, document skipped": true,
+	}
+	if len(issues) != len(expected) {
+		t.Errorf("Expected %d issues, got %v", len(expected), issues)
+	}
+	for _, issue := range issues {
+		if !expected[issue.String()] {
+			t.Errorf("Unexpected issue %q", issue)
+		}
+	}
+}
+
+func TestImportErrors(t *testing.T) {
+	var tests = []struct {
+		name   string
+		bundle string
+	}{
+		{"missing name", "schema: armada/Chart/v1\nmetadata:\n  schema: metadata/Document/v1\ndata: {}\n"},
+		{"duplicate", "schema: armada/Manifest/v1\nmetadata:\n  name: a\n---\nschema: armada/Manifest/v1\nmetadata:\n  name: a\n"},
+		{"invalid yaml", "schema: [armada\n"},
+	}
+
+	for _, test := range tests {
+		if _, _, err := Import(strings.NewReader(test.bundle), ImportOptions{}); err == nil {
+			t.Errorf("%s: expected an error", test.name)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lifecycle is the state machine shared by the armada and the
+// openstacklcm resources. It tells how the actual state of a resource
+// moves when a condition is set, and whether the actual state satisfies
+// the target state. Both API groups use the same state and condition
+// names; they convert their own string types to the ones of this package.
+package lifecycle
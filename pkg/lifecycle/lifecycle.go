@@ -0,0 +1,227 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"fmt"
+)
+
+// State is the actual state of a resource
+type State string
+
+// ConditionType is the type of the condition driving a transition
+type ConditionType string
+
+// ConditionStatus is the status of the condition driving a transition
+type ConditionStatus string
+
+// States of the machine. The empty state, of a resource never reconciled,
+// behaves as Unknown.
+const (
+	Uninitialized State = "uninitialized"
+	Unknown       State = "unknown"
+	Initialized   State = "initialized"
+	Deployed      State = "deployed"
+	Uninstalled   State = "uninstalled"
+	Failed        State = "failed"
+	Pending       State = "pending"
+	Running       State = "running"
+	Error         State = "error"
+)
+
+// States lists all the states, the empty one included
+var States = []State{"", Uninitialized, Unknown, Initialized, Deployed, Uninstalled, Failed, Pending, Running, Error}
+
+// Condition types driving the machine. The other types, Ready for instance,
+// never change the state.
+const (
+	ConditionIrreconcilable ConditionType = "Irreconcilable"
+	ConditionPending        ConditionType = "Pending"
+	ConditionInitialized    ConditionType = "Initializing"
+	ConditionError          ConditionType = "Error"
+	ConditionRunning        ConditionType = "Running"
+	ConditionDeployed       ConditionType = "Deployed"
+	ConditionFailed         ConditionType = "Failed"
+)
+
+// ConditionTypes lists the condition types driving the machine
+var ConditionTypes = []ConditionType{
+	ConditionIrreconcilable, ConditionPending, ConditionInitialized, ConditionError,
+	ConditionRunning, ConditionDeployed, ConditionFailed,
+}
+
+// Condition statuses
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// ConditionStatuses lists the condition statuses
+var ConditionStatuses = []ConditionStatus{ConditionTrue, ConditionFalse, ConditionUnknown}
+
+// Action is what a transition does to the state
+type Action string
+
+const (
+	// Move changes the state to Transition.To
+	Move Action = "Move"
+	// Keep leaves the state as is: the condition is legal but brings
+	// nothing new, as Initializing once the resource is deployed.
+	Keep Action = "Keep"
+	// Reject leaves the state as is and reports the transition: the
+	// condition contradicts the state. No cell of the table rejects at
+	// the moment: a resource may be deployed or fail straight from the
+	// uninitialized state the defaulting sets, or after an uninstall.
+	Reject Action = "Reject"
+)
+
+// Transition is a cell of the table
+type Transition struct {
+	Action Action
+	To     State
+	// KeepReason moves the reason of the condition to the status
+	KeepReason bool
+}
+
+var keep = Transition{Action: Keep}
+
+func move(to State) Transition {
+	return Transition{Action: Move, To: to}
+}
+
+func fail(to State) Transition {
+	return Transition{Action: Move, To: to, KeepReason: true}
+}
+
+// rule gives the transitions for one condition type and status: the
+// default one, and the ones from specific states.
+type rule struct {
+	def  Transition
+	from map[State]Transition
+}
+
+// table holds the transitions of the conditions set to True or False.
+// A condition whose status is Unknown, or whose type is not listed,
+// keeps the state.
+var table = map[ConditionType]map[ConditionStatus]rule{
+	ConditionPending: {
+		ConditionTrue:  {def: move(Pending)},
+		ConditionFalse: {def: keep},
+	},
+	ConditionInitialized: {
+		// The condition is set almost systematically; it only matters
+		// to a resource not acted upon yet or reinstalled.
+		ConditionTrue: {def: keep, from: map[State]Transition{
+			Unknown:       move(Initialized),
+			Uninitialized: move(Initialized),
+			Uninstalled:   move(Initialized),
+		}},
+		ConditionFalse: {def: keep},
+	},
+	ConditionRunning: {
+		ConditionTrue:  {def: move(Running)},
+		ConditionFalse: {def: keep},
+	},
+	ConditionDeployed: {
+		ConditionTrue: {def: move(Deployed)},
+		// Only a resource which may have been deployed is uninstalled.
+		// One still in the works or failing is left as is.
+		ConditionFalse: {def: keep, from: map[State]Transition{
+			Unknown:     move(Uninstalled),
+			Deployed:    move(Uninstalled),
+			Uninstalled: move(Uninstalled),
+		}},
+	},
+	ConditionFailed: {
+		ConditionTrue:  {def: fail(Failed)},
+		ConditionFalse: {def: keep},
+	},
+	ConditionIrreconcilable: {
+		ConditionTrue:  {def: fail(Error)},
+		ConditionFalse: {def: keep},
+	},
+	ConditionError: {
+		ConditionTrue:  {def: fail(Error)},
+		ConditionFalse: {def: keep},
+	},
+}
+
+// Next returns the transition from a state when a condition is set
+func Next(from State, conditionType ConditionType, status ConditionStatus) Transition {
+	if from == "" {
+		from = Unknown
+	}
+	r, ok := table[conditionType][status]
+	if !ok {
+		return keep
+	}
+	if t, ok := r.from[from]; ok {
+		return t
+	}
+	return r.def
+}
+
+// Satisfied returns true if the actual state meets the target state. The
+// running, failed and error states never do.
+func Satisfied(actual State, target State) bool {
+	switch actual {
+	case Running, Failed, Error:
+		return false
+	}
+	return actual == target
+}
+
+// RejectedTransitionError reports a condition which contradicts the state
+type RejectedTransitionError struct {
+	From   State
+	Type   ConditionType
+	Status ConditionStatus
+}
+
+func (e *RejectedTransitionError) Error() string {
+	from := e.From
+	if from == "" {
+		from = Unknown
+	}
+	return fmt.Sprintf("condition %s=%s rejected in state %s", e.Type, e.Status, from)
+}
+
+// Result is the outcome of Compute
+type Result struct {
+	State     State
+	Satisfied bool
+	// Reason is the reason of the status
+	Reason string
+}
+
+// Compute applies a condition to the current state and reason. On a
+// rejected transition the state and reason are kept, Satisfied is still
+// computed and a *RejectedTransitionError is returned.
+func Compute(state State, reason string, conditionType ConditionType, status ConditionStatus, conditionReason string, target State) (Result, error) {
+	var err error
+	t := Next(state, conditionType, status)
+	switch t.Action {
+	case Move:
+		state = t.To
+		reason = ""
+		if t.KeepReason {
+			reason = conditionReason
+		}
+	case Reject:
+		err = &RejectedTransitionError{From: state, Type: conditionType, Status: status}
+	}
+	return Result{State: state, Satisfied: Satisfied(state, target), Reason: reason}, err
+}
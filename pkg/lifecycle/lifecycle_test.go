@@ -0,0 +1,136 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lifecycle
+
+import (
+	"errors"
+	"testing"
+)
+
+// expected gives, for each condition type and status, the outcome from
+// each state in the order of States: "=" keeps the state, "!" rejects the
+// transition and anything else is the new state.
+var expected = map[ConditionType]map[ConditionStatus][]string{
+	//                                "", uninitialized, unknown, initialized, deployed, uninstalled, failed, pending, running, error
+	ConditionPending: {
+		ConditionTrue:    {"pending", "pending", "pending", "pending", "pending", "pending", "pending", "pending", "pending", "pending"},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionInitialized: {
+		ConditionTrue:    {"initialized", "initialized", "initialized", "=", "=", "initialized", "=", "=", "=", "="},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionRunning: {
+		ConditionTrue:    {"running", "running", "running", "running", "running", "running", "running", "running", "running", "running"},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionDeployed: {
+		ConditionTrue:    {"deployed", "deployed", "deployed", "deployed", "deployed", "deployed", "deployed", "deployed", "deployed", "deployed"},
+		ConditionFalse:   {"uninstalled", "=", "uninstalled", "=", "uninstalled", "uninstalled", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionFailed: {
+		ConditionTrue:    {"failed", "failed", "failed", "failed", "failed", "failed", "failed", "failed", "failed", "failed"},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionIrreconcilable: {
+		ConditionTrue:    {"error", "error", "error", "error", "error", "error", "error", "error", "error", "error"},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	ConditionError: {
+		ConditionTrue:    {"error", "error", "error", "error", "error", "error", "error", "error", "error", "error"},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+	// Not part of the machine
+	"Ready": {
+		ConditionTrue:    {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionFalse:   {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+		ConditionUnknown: {"=", "=", "=", "=", "=", "=", "=", "=", "=", "="},
+	},
+}
+
+func TestTableIsComplete(t *testing.T) {
+	for _, conditionType := range ConditionTypes {
+		for _, status := range ConditionStatuses {
+			if len(expected[conditionType][status]) != len(States) {
+				t.Errorf("missing expectations for %s=%s", conditionType, status)
+			}
+		}
+	}
+}
+
+func TestCompute(t *testing.T) {
+	targets := append([]State{}, States[1:]...)
+	for conditionType, byStatus := range expected {
+		for status, outcomes := range byStatus {
+			for i, from := range States {
+				outcome := outcomes[i]
+				for _, target := range targets {
+					res, err := Compute(from, "before", conditionType, status, "InstallError", target)
+
+					wantState := from
+					wantReason := "before"
+					switch outcome {
+					case "=":
+					case "!":
+					default:
+						wantState = State(outcome)
+						wantReason = ""
+						if wantState == Failed || wantState == Error {
+							wantReason = "InstallError"
+						}
+					}
+					if res.State != wantState || res.Reason != wantReason {
+						t.Errorf("%s=%s from %q: got %q/%q, expected %q/%q",
+							conditionType, status, from, res.State, res.Reason, wantState, wantReason)
+					}
+
+					var rejected *RejectedTransitionError
+					if (outcome == "!") != errors.As(err, &rejected) {
+						t.Errorf("%s=%s from %q: unexpected error %v", conditionType, status, from, err)
+					}
+
+					if res.Satisfied != Satisfied(wantState, target) {
+						t.Errorf("%s=%s from %q to %s: unexpected satisfied %t", conditionType, status, from, target, res.Satisfied)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSatisfied(t *testing.T) {
+	for _, actual := range States {
+		for _, target := range States {
+			want := actual == target && actual != Running && actual != Failed && actual != Error
+			if Satisfied(actual, target) != want {
+				t.Errorf("Satisfied(%q, %q) should be %t", actual, target, want)
+			}
+		}
+	}
+}
+
+func TestRejectedTransitionError(t *testing.T) {
+	err := &RejectedTransitionError{Type: ConditionDeployed, Status: ConditionTrue}
+	if err.Error() != "condition Deployed=True rejected in state unknown" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// Defaults applied by armada when the chart does not set them
+const (
+	// DefaultWaitTimeout is the time in seconds allotted to a release
+	DefaultWaitTimeout int64 = 900
+	// DefaultTestTimeout is the time in seconds allotted to the helm tests
+	DefaultTestTimeout int64 = 300
+)
+
+// Plan is the ordered list of the releases deployed by an ArmadaManifest
+type Plan struct {
+	Manifest      string `json:"manifest"`
+	Namespace     string `json:"namespace,omitempty"`
+	ReleasePrefix string `json:"releasePrefix"`
+	// Steps follow the order of the chart groups and of the charts within
+	// each group. Waves tells which of them are deployed in parallel.
+	Steps []Step `json:"steps"`
+	// Waves lists the names of the releases deployed together
+	Waves [][]string `json:"waves"`
+}
+
+// Step is the deployment of one ArmadaChart
+type Step struct {
+	// Group is the ArmadaChartGroup listing the chart
+	Group     string `json:"group"`
+	Sequenced bool   `json:"sequenced,omitempty"`
+	// Chart is the name of the ArmadaChart, ChartName the name of the
+	// helm chart it deploys.
+	Chart     string `json:"chart"`
+	ChartName string `json:"chartName"`
+	// Release is the helm release name, prefixed by the manifest
+	Release   string `json:"release,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Library charts are listed for completeness but never deployed
+	Library bool `json:"library,omitempty"`
+	// Wave is the position of the chart in the deployment, starting at 1.
+	// The charts of a wave are deployed in parallel.
+	Wave int `json:"wave,omitempty"`
+	// DependsOn lists the charts which must be ready first
+	DependsOn   []string              `json:"dependsOn,omitempty"`
+	TargetState av1.HelmResourceState `json:"targetState"`
+	Protected   bool                  `json:"protected,omitempty"`
+	Wait        *Wait                 `json:"wait,omitempty"`
+	Test        *Test                 `json:"test,omitempty"`
+}
+
+// Wait describes how armada waits for a release to be ready
+type Wait struct {
+	// Timeout in seconds, defaulted as armada does
+	Timeout   int64             `json:"timeout"`
+	Native    bool              `json:"native"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Resources []WaitResource    `json:"resources,omitempty"`
+}
+
+// WaitResource is a kind of resource armada waits for
+type WaitResource struct {
+	Type string `json:"type"`
+	// Labels include the labels common to all the resources
+	Labels   map[string]string   `json:"labels,omitempty"`
+	MinReady *intstr.IntOrString `json:"minReady,omitempty"`
+}
+
+// Test describes the helm tests run once the release is ready
+type Test struct {
+	Enabled bool  `json:"enabled"`
+	Timeout int64 `json:"timeout,omitempty"`
+	Cleanup bool  `json:"cleanup,omitempty"`
+}
+
+// New computes the plan of a manifest. The chart groups and charts are
+// looked up by name in the namespace of the manifest; a missing one is an
+// error, as is a dependency cycle.
+func New(manifest *av1.ArmadaManifest, groups []av1.ArmadaChartGroup, charts []av1.ArmadaChart) (*Plan, error) {
+	groupsByName := make(map[string]*av1.ArmadaChartGroup)
+	for i := range groups {
+		if groups[i].Namespace == manifest.Namespace {
+			groupsByName[groups[i].Name] = &groups[i]
+		}
+	}
+	chartsByName := make(map[string]*av1.ArmadaChart)
+	for i := range charts {
+		if charts[i].Namespace == manifest.Namespace {
+			chartsByName[charts[i].Name] = &charts[i]
+		}
+	}
+
+	// Resolve the references, in deployment order
+	manifestGroups := make([]av1.ArmadaChartGroup, 0, len(manifest.Spec.ChartGroups))
+	manifestCharts := make([]av1.ArmadaChart, 0)
+	for _, groupName := range manifest.Spec.ChartGroups {
+		group, ok := groupsByName[groupName]
+		if !ok {
+			return nil, fmt.Errorf("ArmadaChartGroup %q not found", groupName)
+		}
+		manifestGroups = append(manifestGroups, *group)
+		for _, chartName := range group.Spec.Charts {
+			chart, ok := chartsByName[chartName]
+			if !ok {
+				return nil, fmt.Errorf("ArmadaChartGroup %s: ArmadaChart %q not found", groupName, chartName)
+			}
+			manifestCharts = append(manifestCharts, *chart)
+		}
+	}
+
+	graph, err := av1.NewManifestChartGraph(manifestGroups, manifestCharts)
+	if err != nil {
+		return nil, err
+	}
+	waves := make(map[string]int)
+	for i, wave := range graph.Waves() {
+		for _, name := range wave {
+			waves[name] = i + 1
+		}
+	}
+
+	plan := &Plan{
+		Manifest:      manifest.Name,
+		Namespace:     manifest.Namespace,
+		ReleasePrefix: manifest.Spec.ReleasePrefix,
+		Steps:         make([]Step, 0, len(manifestCharts)),
+		Waves:         make([][]string, 0),
+	}
+	planned := make(map[string]bool)
+	for _, group := range manifestGroups {
+		for _, chartName := range group.Spec.Charts {
+			if planned[chartName] {
+				// Deployed with the first group listing it
+				continue
+			}
+			planned[chartName] = true
+			plan.Steps = append(plan.Steps, newStep(plan, &group, chartsByName[chartName], waves[chartName], graph))
+		}
+	}
+	for _, wave := range graph.Waves() {
+		releases := make([]string, 0, len(wave))
+		for _, name := range wave {
+			releases = append(releases, ReleaseName(plan.ReleasePrefix, chartsByName[name].Spec.Release))
+		}
+		plan.Waves = append(plan.Waves, releases)
+	}
+	return plan, nil
+}
+
+// ReleaseName returns the name of the helm release as armada prefixes it
+func ReleaseName(prefix string, release string) string {
+	if prefix == "" {
+		return release
+	}
+	return prefix + "-" + release
+}
+
+func newStep(plan *Plan, group *av1.ArmadaChartGroup, chart *av1.ArmadaChart, wave int, graph *av1.ChartGraph) Step {
+	spec := &chart.Spec
+	step := Step{
+		Group:       group.Name,
+		Sequenced:   group.Spec.Sequenced,
+		Chart:       chart.Name,
+		ChartName:   spec.ChartName,
+		Library:     chart.IsLibrary(),
+		TargetState: spec.TargetState,
+		Protected:   spec.Protected != nil,
+	}
+	if step.Library {
+		return step
+	}
+
+	step.Release = ReleaseName(plan.ReleasePrefix, spec.Release)
+	step.Namespace = spec.Namespace
+	if step.Namespace == "" {
+		step.Namespace = chart.Namespace
+	}
+	step.Wave = wave
+	step.DependsOn = graph.Prerequisites(chart.Name)
+
+	// The deprecated spec.timeout still applies when wait.timeout is unset
+	step.Wait = &Wait{Timeout: int64(spec.Timeout), Native: true}
+	if spec.Wait != nil {
+		if spec.Wait.Timeout > 0 {
+			step.Wait.Timeout = spec.Wait.Timeout
+		}
+		if spec.Wait.Native != nil {
+			step.Wait.Native = spec.Wait.Native.Enabled
+		}
+		if spec.Wait.Labels != nil {
+			step.Wait.Labels = *spec.Wait.Labels
+		}
+		for _, item := range spec.Wait.Resources {
+			if item == nil {
+				continue
+			}
+			resource := WaitResource{Type: item.Type}
+			if item.MinReady != nil {
+				minReady := *item.MinReady
+				resource.MinReady = &minReady
+			}
+			if len(step.Wait.Labels) > 0 || item.Labels != nil {
+				resource.Labels = make(map[string]string)
+				for k, v := range step.Wait.Labels {
+					resource.Labels[k] = v
+				}
+				if item.Labels != nil {
+					for k, v := range *item.Labels {
+						resource.Labels[k] = v
+					}
+				}
+			}
+			step.Wait.Resources = append(step.Wait.Resources, resource)
+		}
+	}
+	if step.Wait.Timeout <= 0 {
+		step.Wait.Timeout = DefaultWaitTimeout
+	}
+
+	// armada runs the tests of a chart without a test section
+	step.Test = &Test{Enabled: true, Timeout: DefaultTestTimeout}
+	if spec.Test != nil {
+		step.Test.Enabled = spec.Test.Enabled
+		if spec.Test.Timeout > 0 {
+			step.Test.Timeout = spec.Test.Timeout
+		}
+		if spec.Test.Options != nil {
+			step.Test.Cleanup = spec.Test.Options.Cleanup
+		}
+	}
+	return step
+}
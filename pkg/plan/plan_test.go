@@ -0,0 +1,132 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func newChart(name string, deps ...string) av1.ArmadaChart {
+	return av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec: av1.ArmadaChartSpec{
+			ChartName:    name,
+			Release:      name,
+			Namespace:    "openstack",
+			Dependencies: deps,
+			TargetState:  av1.StateDeployed,
+		},
+	}
+}
+
+func newGroup(name string, sequenced bool, charts ...string) av1.ArmadaChartGroup {
+	return av1.ArmadaChartGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       av1.ArmadaChartGroupSpec{Charts: charts, Sequenced: sequenced},
+	}
+}
+
+func TestNew(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	htk := newChart("helm-toolkit")
+	htk.Spec.Library = true
+	mariadb := newChart("mariadb")
+	mariadb.Spec.Timeout = 600
+	keystone := newChart("keystone", "helm-toolkit")
+	keystone.Spec.Namespace = ""
+	minReady := intstr.FromString("80%")
+	keystone.Spec.Wait = &av1.ArmadaWait{
+		Timeout: 1200,
+		Labels:  &map[string]string{"release_group": "osh-keystone"},
+		Resources: []*av1.ArmadaWaitResourcesItems{
+			{Type: "job", Labels: &map[string]string{"application": "keystone"}},
+			{Type: "deployment", MinReady: &minReady},
+		},
+	}
+	keystone.Spec.Test = &av1.ArmadaTest{Enabled: true, Timeout: 60, Options: &av1.ArmadaTestOptions{Cleanup: true}}
+	glance := newChart("glance", "keystone")
+	glance.Spec.Test = &av1.ArmadaTest{}
+	glance.Spec.Protected = &av1.ArmadaProtectedRelease{}
+
+	manifest := &av1.ArmadaManifest{
+		ObjectMeta: metav1.ObjectMeta{Name: "osh", Namespace: "default"},
+		Spec: av1.ArmadaManifestSpec{
+			ChartGroups:   []string{"infra", "openstack"},
+			ReleasePrefix: "osh",
+		},
+	}
+	groups := []av1.ArmadaChartGroup{
+		newGroup("openstack", false, "keystone", "glance", "memcached"),
+		newGroup("infra", true, "helm-toolkit", "mariadb", "memcached"),
+	}
+	charts := []av1.ArmadaChart{htk, mariadb, keystone, glance, newChart("memcached")}
+
+	plan, err := New(manifest, groups, charts)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(plan.Waves).To(gomega.Equal([][]string{
+		{"osh-mariadb"},
+		{"osh-memcached"},
+		{"osh-keystone"},
+		{"osh-glance"},
+	}))
+
+	g.Expect(plan.Steps).To(gomega.HaveLen(5))
+	g.Expect(plan.Steps[0]).To(gomega.Equal(Step{
+		Group:       "infra",
+		Sequenced:   true,
+		Chart:       "helm-toolkit",
+		ChartName:   "helm-toolkit",
+		Library:     true,
+		TargetState: av1.StateDeployed,
+	}))
+	g.Expect(plan.Steps[1].Wait).To(gomega.Equal(&Wait{Timeout: 600, Native: true}))
+	g.Expect(plan.Steps[1].Test).To(gomega.Equal(&Test{Enabled: true, Timeout: DefaultTestTimeout}))
+	g.Expect(plan.Steps[2].Group).To(gomega.Equal("infra"))
+	g.Expect(plan.Steps[2].DependsOn).To(gomega.Equal([]string{"mariadb"}))
+	g.Expect(plan.Steps[3]).To(gomega.Equal(Step{
+		Group:       "openstack",
+		Chart:       "keystone",
+		ChartName:   "keystone",
+		Release:     "osh-keystone",
+		Namespace:   "default",
+		Wave:        3,
+		DependsOn:   []string{"mariadb", "memcached"},
+		TargetState: av1.StateDeployed,
+		Wait: &Wait{
+			Timeout: 1200,
+			Native:  true,
+			Labels:  map[string]string{"release_group": "osh-keystone"},
+			Resources: []WaitResource{
+				{Type: "job", Labels: map[string]string{"release_group": "osh-keystone", "application": "keystone"}},
+				{Type: "deployment", Labels: map[string]string{"release_group": "osh-keystone"}, MinReady: &minReady},
+			},
+		},
+		Test: &Test{Enabled: true, Timeout: 60, Cleanup: true},
+	}))
+	g.Expect(plan.Steps[4].Namespace).To(gomega.Equal("openstack"))
+	g.Expect(plan.Steps[4].Protected).To(gomega.BeTrue())
+	g.Expect(plan.Steps[4].Wait.Timeout).To(gomega.Equal(DefaultWaitTimeout))
+	g.Expect(plan.Steps[4].Test).To(gomega.Equal(&Test{Enabled: false, Timeout: DefaultTestTimeout}))
+
+	manifest.Spec.ChartGroups = append(manifest.Spec.ChartGroups, "missing")
+	_, err = New(manifest, groups, charts)
+	g.Expect(err).To(gomega.MatchError(`ArmadaChartGroup "missing" not found`))
+}
@@ -0,0 +1,21 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package revision records the history of the specs of the armada objects
+// in apps/v1 ControllerRevisions, as the StatefulSet and DaemonSet
+// controllers do for their templates. A revision holds the JSON of a spec,
+// is owned by the object and is named after the hash of the spec.
+// The history is pruned down to the RevisionHistoryLimit of the spec and
+// a former spec can be read back for a rollback.
+package revision
@@ -0,0 +1,138 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// History reads and writes the revisions of the objects through a client
+type History struct {
+	client client.Client
+	scheme *runtime.Scheme
+}
+
+// NewHistory returns a History. The scheme provides the GroupVersionKinds
+// of the owner references.
+func NewHistory(c client.Client, scheme *runtime.Scheme) *History {
+	return &History{client: c, scheme: scheme}
+}
+
+// List returns the revisions controlled by owner, from the oldest to the
+// newest.
+func (h *History) List(ctx context.Context, owner client.Object) ([]*appsv1.ControllerRevision, error) {
+	list := &appsv1.ControllerRevisionList{}
+	if err := h.client.List(ctx, list, client.InNamespace(owner.GetNamespace())); err != nil {
+		return nil, err
+	}
+	res := make([]*appsv1.ControllerRevision, 0, len(list.Items))
+	for i := range list.Items {
+		if metav1.IsControlledBy(&list.Items[i], owner) {
+			res = append(res, &list.Items[i])
+		}
+	}
+	Sort(res)
+	return res, nil
+}
+
+// Record makes spec the latest revision of owner and prunes the history
+// down to limit. A spec already recorded gets its revision renumbered
+// instead of a new one.
+func (h *History) Record(ctx context.Context, owner client.Object, spec interface{}, limit *int32) (*appsv1.ControllerRevision, error) {
+	gvk, err := apiutil.GVKForObject(owner, h.scheme)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := h.List(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *appsv1.ControllerRevision
+	var collisionCount *int32
+	for latest == nil {
+		rev, err := Snapshot(owner, gvk, spec, Next(revisions), collisionCount)
+		if err != nil {
+			return nil, err
+		}
+
+		if i := indexOfEqual(revisions, rev); i >= 0 {
+			latest = revisions[i]
+			if i != len(revisions)-1 {
+				latest.Revision = rev.Revision
+				if err := h.client.Update(ctx, latest); err != nil {
+					return nil, err
+				}
+				revisions = append(append(revisions[:i:i], revisions[i+1:]...), latest)
+			}
+			break
+		}
+
+		err = h.client.Create(ctx, rev)
+		if apierrors.IsAlreadyExists(err) {
+			// The name is taken by another spec
+			count := int32(0)
+			if collisionCount != nil {
+				count = *collisionCount + 1
+			}
+			collisionCount = &count
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		latest = rev
+		revisions = append(revisions, rev)
+	}
+
+	for _, rev := range Truncate(revisions, limit) {
+		if err := h.client.Delete(ctx, rev); err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+	}
+	return latest, nil
+}
+
+// Rollback decodes the spec recorded by the given revision of owner into
+// spec. It returns a *NotFoundError if the revision was pruned.
+func (h *History) Rollback(ctx context.Context, owner client.Object, revision int64, spec interface{}) error {
+	revisions, err := h.List(ctx, owner)
+	if err != nil {
+		return err
+	}
+	rev, err := Find(revisions, revision)
+	if err != nil {
+		return err
+	}
+	return Spec(rev, spec)
+}
+
+// indexOfEqual returns the index of the revision recording the same spec
+// as rev, -1 if none does.
+func indexOfEqual(revisions []*appsv1.ControllerRevision, rev *appsv1.ControllerRevision) int {
+	for i := range revisions {
+		if Equal(revisions[i], rev) {
+			return i
+		}
+	}
+	return -1
+}
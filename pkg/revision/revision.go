@@ -0,0 +1,157 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// DefaultRevisionHistoryLimit is used when the spec does not set one
+const DefaultRevisionHistoryLimit int32 = 10
+
+// HashLabel holds the hash of the spec recorded by a revision
+const HashLabel = appsv1.ControllerRevisionHashLabelKey
+
+// NotFoundError reports a revision missing from the history
+type NotFoundError struct {
+	Revision int64
+}
+
+func (e *NotFoundError) Error() string {
+	return fmt.Sprintf("revision %d not found", e.Revision)
+}
+
+// Hash returns the hash of the recorded spec. The collision count, if not
+// nil, is added so that a new name can be found when two specs collide.
+func Hash(data []byte, collisionCount *int32) string {
+	hf := fnv.New32a()
+	hf.Write(data)
+	if collisionCount != nil {
+		hf.Write([]byte(strconv.FormatInt(int64(*collisionCount), 10)))
+	}
+	return rand.SafeEncodeString(strconv.FormatUint(uint64(hf.Sum32()), 10))
+}
+
+// Name returns the name of the revision of the owner with the given hash
+func Name(owner metav1.Object, hash string) string {
+	return owner.GetName() + "-" + hash
+}
+
+// Snapshot records spec as the given revision of owner. The JSON encoding
+// of a spec is stable: the fields come in the order of the struct and the
+// map keys are sorted, so that the same spec always gets the same hash.
+func Snapshot(owner metav1.Object, gvk schema.GroupVersionKind, spec interface{}, revision int64, collisionCount *int32) (*appsv1.ControllerRevision, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	hash := Hash(data, collisionCount)
+	labels := make(map[string]string, len(owner.GetLabels())+1)
+	for k, v := range owner.GetLabels() {
+		labels[k] = v
+	}
+	labels[HashLabel] = hash
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            Name(owner, hash),
+			Namespace:       owner.GetNamespace(),
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(owner, gvk)},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revision,
+	}, nil
+}
+
+// Equal returns true if both revisions record the same spec. The hashes
+// are not compared: they differ after a collision.
+func Equal(a *appsv1.ControllerRevision, b *appsv1.ControllerRevision) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return bytes.Equal(a.Data.Raw, b.Data.Raw)
+}
+
+// Sort orders the revisions from the oldest to the newest: by revision
+// number, then by creation time and name.
+func Sort(revisions []*appsv1.ControllerRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		a, b := revisions[i], revisions[j]
+		if a.Revision != b.Revision {
+			return a.Revision < b.Revision
+		}
+		if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+			return a.CreationTimestamp.Before(&b.CreationTimestamp)
+		}
+		return a.Name < b.Name
+	})
+}
+
+// Next returns the number of the revision following the sorted ones
+func Next(revisions []*appsv1.ControllerRevision) int64 {
+	if len(revisions) == 0 {
+		return 1
+	}
+	return revisions[len(revisions)-1].Revision + 1
+}
+
+// Find returns the revision with the given number
+func Find(revisions []*appsv1.ControllerRevision, revision int64) (*appsv1.ControllerRevision, error) {
+	for _, rev := range revisions {
+		if rev.Revision == revision {
+			return rev, nil
+		}
+	}
+	return nil, &NotFoundError{Revision: revision}
+}
+
+// Spec decodes the spec recorded by a revision into spec, a pointer to the
+// spec type of the owner.
+func Spec(rev *appsv1.ControllerRevision, spec interface{}) error {
+	if len(rev.Data.Raw) == 0 {
+		return fmt.Errorf("revision %d of %s holds no spec", rev.Revision, rev.Name)
+	}
+	return json.Unmarshal(rev.Data.Raw, spec)
+}
+
+// Truncate returns the revisions to delete so that, besides the latest
+// one which records the spec in use, at most limit revisions are kept.
+// The revisions are sorted; the oldest ones go first. A nil limit means
+// DefaultRevisionHistoryLimit.
+func Truncate(revisions []*appsv1.ControllerRevision, limit *int32) []*appsv1.ControllerRevision {
+	keep := DefaultRevisionHistoryLimit
+	if limit != nil {
+		keep = *limit
+	}
+	if keep < 0 {
+		keep = 0
+	}
+	history := len(revisions) - 1
+	if history <= int(keep) {
+		return nil
+	}
+	return revisions[:history-int(keep)]
+}
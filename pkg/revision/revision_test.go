@@ -0,0 +1,156 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package revision
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newChart(limit *int32) *av1.ArmadaChart {
+	return &av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: "keystone", Namespace: "openstack", UID: "keystone-uid"},
+		Spec: av1.ArmadaChartSpec{
+			ChartName:            "keystone",
+			Release:              "keystone",
+			TargetState:          av1.StateDeployed,
+			RevisionHistoryLimit: limit,
+		},
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	chart := newChart(nil)
+	gvk := av1.SchemeGroupVersion.WithKind("ArmadaChart")
+
+	first, err := Snapshot(chart, gvk, chart.Spec, 1, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	again, err := Snapshot(chart, gvk, chart.Spec, 2, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(again.Name).To(gomega.Equal(first.Name))
+	g.Expect(first.Labels[HashLabel]).NotTo(gomega.BeEmpty())
+	g.Expect(first.Namespace).To(gomega.Equal("openstack"))
+	g.Expect(metav1.IsControlledBy(first, chart)).To(gomega.BeTrue())
+	g.Expect(Equal(first, again)).To(gomega.BeTrue())
+
+	// A collision gives another name to the same spec
+	count := int32(0)
+	collided, err := Snapshot(chart, gvk, chart.Spec, 2, &count)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(collided.Name).NotTo(gomega.Equal(first.Name))
+	g.Expect(Equal(first, collided)).To(gomega.BeTrue())
+
+	chart.Spec.Release = "keystone-2"
+	changed, err := Snapshot(chart, gvk, chart.Spec, 2, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changed.Name).NotTo(gomega.Equal(first.Name))
+	g.Expect(Equal(first, changed)).To(gomega.BeFalse())
+
+	spec := av1.ArmadaChartSpec{}
+	g.Expect(Spec(first, &spec)).To(gomega.Succeed())
+	g.Expect(spec.Release).To(gomega.Equal("keystone"))
+}
+
+func TestSortAndTruncate(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	revisions := make([]*appsv1.ControllerRevision, 0)
+	for _, n := range []int64{4, 1, 3, 2, 5} {
+		revisions = append(revisions, &appsv1.ControllerRevision{Revision: n})
+	}
+	Sort(revisions)
+	for i, rev := range revisions {
+		g.Expect(rev.Revision).To(gomega.Equal(int64(i + 1)))
+	}
+	g.Expect(Next(revisions)).To(gomega.Equal(int64(6)))
+	g.Expect(Next(nil)).To(gomega.Equal(int64(1)))
+
+	limit := int32(2)
+	removed := Truncate(revisions, &limit)
+	g.Expect(removed).To(gomega.HaveLen(2))
+	g.Expect(removed[1].Revision).To(gomega.Equal(int64(2)))
+	zero := int32(0)
+	g.Expect(Truncate(revisions, &zero)).To(gomega.HaveLen(4))
+	g.Expect(Truncate(revisions, nil)).To(gomega.BeEmpty())
+
+	_, err := Find(revisions, 7)
+	var notFound *NotFoundError
+	g.Expect(errors.As(err, &notFound)).To(gomega.BeTrue())
+	g.Expect(err.Error()).To(gomega.Equal("revision 7 not found"))
+}
+
+func TestHistory(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ctx := context.TODO()
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(gomega.Succeed())
+	g.Expect(av1.AddToScheme(scheme)).To(gomega.Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	h := NewHistory(c, scheme)
+
+	limit := int32(2)
+	chart := newChart(&limit)
+	for _, release := range []string{"r1", "r2", "r3", "r4"} {
+		chart.Spec.Release = release
+		_, err := h.Record(ctx, chart, chart.Spec, chart.Spec.RevisionHistoryLimit)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+	}
+
+	// The latest revision and two former ones are kept
+	revisions, err := h.List(ctx, chart)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revisions).To(gomega.HaveLen(3))
+	g.Expect(revisions[0].Revision).To(gomega.Equal(int64(2)))
+	g.Expect(revisions[2].Revision).To(gomega.Equal(int64(4)))
+
+	// Recording the latest spec again changes nothing
+	latest, err := h.Record(ctx, chart, chart.Spec, chart.Spec.RevisionHistoryLimit)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(latest.Revision).To(gomega.Equal(int64(4)))
+
+	// Going back to a former spec renumbers its revision
+	spec := av1.ArmadaChartSpec{}
+	g.Expect(h.Rollback(ctx, chart, 2, &spec)).To(gomega.Succeed())
+	g.Expect(spec.Release).To(gomega.Equal("r2"))
+	latest, err = h.Record(ctx, chart, spec, spec.RevisionHistoryLimit)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(latest.Revision).To(gomega.Equal(int64(5)))
+	revisions, err = h.List(ctx, chart)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revisions).To(gomega.HaveLen(3))
+	g.Expect(revisions[0].Revision).To(gomega.Equal(int64(3)))
+
+	var notFound *NotFoundError
+	g.Expect(errors.As(h.Rollback(ctx, chart, 1, &spec), &notFound)).To(gomega.BeTrue())
+
+	// The revisions of another object are not listed
+	other := newChart(nil)
+	other.Name = "glance"
+	other.UID = "glance-uid"
+	revisions, err = h.List(ctx, other)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(revisions).To(gomega.BeEmpty())
+}
@@ -0,0 +1,19 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rollout evaluates the rollout of the Deployments, StatefulSets
+// and DaemonSets with the semantics of kubectl rollout status. It is shared
+// by the readiness of the openstacklcm dependencies and by the armada
+// waits, the latter relaxing the availability with a min_ready.
+package rollout
@@ -0,0 +1,162 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// State of a rollout, as reported by kubectl rollout status
+type State string
+
+// States of a rollout
+const (
+	Progressing State = "Progressing"
+	Complete    State = "Complete"
+	Failed      State = "Failed"
+)
+
+// ProgressDeadlineExceeded is the reason of the Progressing condition of a
+// Deployment which missed its progressDeadlineSeconds
+const ProgressDeadlineExceeded = "ProgressDeadlineExceeded"
+
+// Status is the state of a rollout and, unless it is complete, why
+type Status struct {
+	State   State
+	Message string
+}
+
+func progressing(format string, args ...interface{}) Status {
+	return Status{State: Progressing, Message: fmt.Sprintf(format, args...)}
+}
+
+var complete = Status{State: Complete}
+
+// Deployment follows kubectl rollout status: the new generation must be
+// observed, every replica updated, the old ones gone and the updated ones
+// available. minReady, a count or a percentage of the updated replicas,
+// relaxes the availability; nil requires all of them. The error reports an
+// invalid minReady.
+func Deployment(d *appsv1.Deployment, minReady *intstr.IntOrString) (Status, error) {
+	if d.Generation > d.Status.ObservedGeneration {
+		return progressing("waiting for the deployment spec update to be observed"), nil
+	}
+	for _, condition := range d.Status.Conditions {
+		if condition.Type == appsv1.DeploymentProgressing && condition.Reason == ProgressDeadlineExceeded {
+			return Status{State: Failed, Message: "deployment exceeded its progress deadline"}, nil
+		}
+	}
+	replicas := specReplicas(d.Spec.Replicas)
+	if d.Status.UpdatedReplicas < replicas {
+		return progressing("%d of %d new replicas have been updated", d.Status.UpdatedReplicas, replicas), nil
+	}
+	if d.Status.Replicas > d.Status.UpdatedReplicas {
+		return progressing("%d old replicas are pending termination", d.Status.Replicas-d.Status.UpdatedReplicas), nil
+	}
+	ok, want, err := isMinReady(d.Status.AvailableReplicas, d.Status.UpdatedReplicas, minReady)
+	if err != nil {
+		return Status{}, err
+	}
+	if !ok {
+		return progressing("%d of %d updated replicas are available, with min_ready %s",
+			d.Status.AvailableReplicas, d.Status.UpdatedReplicas, want), nil
+	}
+	return complete, nil
+}
+
+// StatefulSet follows kubectl rollout status, minReady applying to the
+// ready replicas. With a partition only the replicas above it are expected
+// to be updated. With the OnDelete strategy the pods are only updated when
+// deleted, hence only the ready replicas are checked. A StatefulSet has no
+// progress deadline, its rollout never fails.
+func StatefulSet(s *appsv1.StatefulSet, minReady *intstr.IntOrString) (Status, error) {
+	if s.Status.ObservedGeneration == 0 || s.Generation > s.Status.ObservedGeneration {
+		return progressing("waiting for the statefulset spec update to be observed"), nil
+	}
+	replicas := specReplicas(s.Spec.Replicas)
+	ok, want, err := isMinReady(s.Status.ReadyReplicas, replicas, minReady)
+	if err != nil {
+		return Status{}, err
+	}
+	if !ok {
+		return progressing("%d of %d pods are ready, with min_ready %s", s.Status.ReadyReplicas, replicas, want), nil
+	}
+	if s.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return complete, nil
+	}
+	if rollingUpdate := s.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil && rollingUpdate.Partition != nil {
+		if s.Status.UpdatedReplicas < replicas-*rollingUpdate.Partition {
+			return progressing("%d of %d new pods have been updated above the partition %d",
+				s.Status.UpdatedReplicas, replicas-*rollingUpdate.Partition, *rollingUpdate.Partition), nil
+		}
+		return complete, nil
+	}
+	if s.Status.UpdateRevision != s.Status.CurrentRevision {
+		return progressing("waiting for the rolling update to complete, %d pods at revision %s",
+			s.Status.UpdatedReplicas, s.Status.UpdateRevision), nil
+	}
+	return complete, nil
+}
+
+// DaemonSet follows kubectl rollout status: every scheduled pod must be
+// updated and available, and none unavailable. minReady, a count or a
+// percentage of the scheduled pods, relaxes the availability. A DaemonSet
+// has no progress deadline, its rollout never fails.
+func DaemonSet(ds *appsv1.DaemonSet, minReady *intstr.IntOrString) (Status, error) {
+	if ds.Generation > ds.Status.ObservedGeneration {
+		return progressing("waiting for the daemonset spec update to be observed"), nil
+	}
+	if ds.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType &&
+		ds.Status.UpdatedNumberScheduled < ds.Status.DesiredNumberScheduled {
+		return progressing("%d of %d new pods have been updated",
+			ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled), nil
+	}
+	ok, want, err := isMinReady(ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled, minReady)
+	if err != nil {
+		return Status{}, err
+	}
+	if !ok {
+		return progressing("%d of %d pods are available, with min_ready %s",
+			ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled, want), nil
+	}
+	if minReady == nil && ds.Status.NumberUnavailable > 0 {
+		return progressing("%d pods are unavailable", ds.Status.NumberUnavailable), nil
+	}
+	return complete, nil
+}
+
+// isMinReady returns true if ready out of total is at least minReady, a
+// count or a percentage of total rounded up. A nil minReady is 100%.
+func isMinReady(ready int32, total int32, minReady *intstr.IntOrString) (bool, string, error) {
+	if minReady == nil {
+		return ready >= total, "100%", nil
+	}
+	required, err := intstr.GetScaledValueFromIntOrPercent(minReady, int(total), true)
+	if err != nil {
+		return false, "", err
+	}
+	return int(ready) >= required, minReady.String(), nil
+}
+
+// specReplicas returns the replicas of a spec, the API defaulting to one
+func specReplicas(replicas *int32) int32 {
+	if replicas == nil {
+		return 1
+	}
+	return *replicas
+}
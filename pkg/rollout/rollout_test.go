@@ -0,0 +1,120 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rollout
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func minReady(s string) *intstr.IntOrString {
+	v := intstr.Parse(s)
+	return &v
+}
+
+func TestDeployment(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	replicas := int32(4)
+	d := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+		Status:     appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 4, AvailableReplicas: 3},
+	}
+
+	status, err := Deployment(d, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status).To(gomega.Equal(Status{State: Progressing, Message: "3 of 4 updated replicas are available, with min_ready 100%"}))
+
+	for _, want := range []string{"3", "75%"} {
+		status, err = Deployment(d, minReady(want))
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		g.Expect(status.State).To(gomega.Equal(Complete), want)
+	}
+	status, err = Deployment(d, minReady("80%"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.State).To(gomega.Equal(Progressing))
+	_, err = Deployment(d, minReady("most"))
+	g.Expect(err).To(gomega.HaveOccurred())
+
+	d.Status.Conditions = []appsv1.DeploymentCondition{{Type: appsv1.DeploymentProgressing, Reason: ProgressDeadlineExceeded}}
+	status, err = Deployment(d, minReady("3"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.State).To(gomega.Equal(Failed))
+}
+
+func TestStatefulSet(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	replicas, partition := int32(3), int32(1)
+	s := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+			UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+				Type:          appsv1.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{Partition: &partition},
+			},
+		},
+		Status: appsv1.StatefulSetStatus{ObservedGeneration: 1, ReadyReplicas: 2, UpdatedReplicas: 2},
+	}
+
+	status, err := StatefulSet(s, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.Message).To(gomega.Equal("2 of 3 pods are ready, with min_ready 100%"))
+
+	// Only the replicas above the partition are updated
+	status, err = StatefulSet(s, minReady("2"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.State).To(gomega.Equal(Complete))
+	s.Status.UpdatedReplicas = 1
+	status, err = StatefulSet(s, minReady("2"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.Message).To(gomega.Equal("1 of 2 new pods have been updated above the partition 1"))
+
+	s.Spec.UpdateStrategy = appsv1.StatefulSetUpdateStrategy{Type: appsv1.OnDeleteStatefulSetStrategyType}
+	status, err = StatefulSet(s, minReady("2"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.State).To(gomega.Equal(Complete))
+}
+
+func TestDaemonSet(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	ds := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Generation: 1},
+		Status: appsv1.DaemonSetStatus{
+			ObservedGeneration:     1,
+			DesiredNumberScheduled: 4,
+			UpdatedNumberScheduled: 4,
+			NumberAvailable:        4,
+			NumberUnavailable:      1,
+		},
+	}
+
+	// Without min_ready no pod may be unavailable
+	status, err := DaemonSet(ds, nil)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status).To(gomega.Equal(Status{State: Progressing, Message: "1 pods are unavailable"}))
+	status, err = DaemonSet(ds, minReady("100%"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.State).To(gomega.Equal(Complete))
+
+	ds.Status.UpdatedNumberScheduled = 3
+	status, err = DaemonSet(ds, minReady("1"))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(status.Message).To(gomega.Equal("3 of 4 new pods have been updated"))
+}
@@ -0,0 +1,129 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/keleustes/armada-crd/pkg/rollout"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// rule checks an object, returning why it is not ready. minReady only
+// applies to the controllers.
+type rule func(u *unstructured.Unstructured, minReady *intstr.IntOrString) (bool, string, error)
+
+var rules = map[string]rule{
+	"pod":         podReady,
+	"job":         jobReady,
+	"deployment":  deploymentReady,
+	"daemonset":   daemonSetReady,
+	"statefulset": statefulSetReady,
+}
+
+// skip returns true for the objects armada does not wait on: the pods of
+// the jobs, which are waited on as jobs, and the pods of the helm tests.
+func skip(resourceType string, u *unstructured.Unstructured) bool {
+	if resourceType != "pod" {
+		return false
+	}
+	for _, ref := range u.GetOwnerReferences() {
+		if ref.Kind == "Job" {
+			return true
+		}
+	}
+	return strings.Contains(u.GetAnnotations()["helm.sh/hook"], "test")
+}
+
+func podReady(u *unstructured.Unstructured, _ *intstr.IntOrString) (bool, string, error) {
+	pod := &corev1.Pod{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), pod); err != nil {
+		return false, "", err
+	}
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		return true, "", nil
+	case corev1.PodFailed:
+		return false, fmt.Sprintf("pod failed: %s", pod.Status.Reason), nil
+	}
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+			return true, "", nil
+		}
+	}
+	return false, fmt.Sprintf("pod is %s and not ready", pod.Status.Phase), nil
+}
+
+func jobReady(u *unstructured.Unstructured, _ *intstr.IntOrString) (bool, string, error) {
+	job := &batchv1.Job{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), job); err != nil {
+		return false, "", err
+	}
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			return false, fmt.Sprintf("job failed: %s", condition.Reason), nil
+		}
+	}
+	completions := int32(1)
+	if job.Spec.Completions != nil {
+		completions = *job.Spec.Completions
+	}
+	if job.Status.Succeeded < completions {
+		return false, fmt.Sprintf("%d of %d completions succeeded", job.Status.Succeeded, completions), nil
+	}
+	return true, "", nil
+}
+
+func deploymentReady(u *unstructured.Unstructured, minReady *intstr.IntOrString) (bool, string, error) {
+	d := &appsv1.Deployment{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), d); err != nil {
+		return false, "", err
+	}
+	return ready(rollout.Deployment(d, minReady))
+}
+
+func daemonSetReady(u *unstructured.Unstructured, minReady *intstr.IntOrString) (bool, string, error) {
+	ds := &appsv1.DaemonSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), ds); err != nil {
+		return false, "", err
+	}
+	return ready(rollout.DaemonSet(ds, minReady))
+}
+
+func statefulSetReady(u *unstructured.Unstructured, minReady *intstr.IntOrString) (bool, string, error) {
+	s := &appsv1.StatefulSet{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), s); err != nil {
+		return false, "", err
+	}
+	return ready(rollout.StatefulSet(s, minReady))
+}
+
+// ready converts the rollout of a controller into the result of a rule.
+// A failed rollout is only not ready: the wait times out on it.
+func ready(status rollout.Status, err error) (bool, string, error) {
+	if err != nil {
+		return false, "", err
+	}
+	if status.State == rollout.Complete {
+		return true, "", nil
+	}
+	return false, status.Message, nil
+}
@@ -0,0 +1,227 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/plan"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Verdict is the outcome of a wait
+type Verdict string
+
+const (
+	// VerdictReady means every resource is ready
+	VerdictReady Verdict = "Ready"
+	// VerdictWaiting means some resources are not ready yet
+	VerdictWaiting Verdict = "Waiting"
+	// VerdictTimedOut means some resources were not ready in time
+	VerdictTimedOut Verdict = "TimedOut"
+)
+
+// kinds maps the types of ArmadaWaitResourcesItems to the kinds they wait on
+var kinds = map[string]schema.GroupKind{
+	"pod":         {Kind: "Pod"},
+	"job":         {Group: "batch", Kind: "Job"},
+	"deployment":  {Group: "apps", Kind: "Deployment"},
+	"daemonset":   {Group: "apps", Kind: "DaemonSet"},
+	"statefulset": {Group: "apps", Kind: "StatefulSet"},
+}
+
+// DefaultResources are the types waited on when the wait lists none. They
+// are optional: a type without any object is ready.
+var DefaultResources = []string{"pod", "job"}
+
+// ResourceReport is the readiness of one object
+type ResourceReport struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Ready     bool   `json:"ready"`
+	// Message explains why the object is not ready
+	Message string `json:"message,omitempty"`
+}
+
+// ItemReport is the readiness of the objects of one wait item
+type ItemReport struct {
+	Type string `json:"type"`
+	// Labels include the labels common to all the items
+	Labels map[string]string `json:"labels,omitempty"`
+	// Required items without any object are not ready
+	Required  bool             `json:"required"`
+	Ready     bool             `json:"ready"`
+	Message   string           `json:"message,omitempty"`
+	Resources []ResourceReport `json:"resources,omitempty"`
+}
+
+// Report is the evaluation of an ArmadaWait
+type Report struct {
+	Verdict  Verdict       `json:"verdict"`
+	Timeout  time.Duration `json:"timeout"`
+	Deadline time.Time     `json:"deadline"`
+	Items    []ItemReport  `json:"items"`
+}
+
+// Ready returns true if every item is ready
+func (r *Report) Ready() bool {
+	return r.Verdict == VerdictReady
+}
+
+// NotReady returns the objects which are not ready
+func (r *Report) NotReady() []ResourceReport {
+	res := make([]ResourceReport, 0)
+	for _, item := range r.Items {
+		for _, resource := range item.Resources {
+			if !resource.Ready {
+				res = append(res, resource)
+			}
+		}
+	}
+	return res
+}
+
+// Evaluate checks the objects of a release against its wait, which started
+// at started. A nil wait waits on the DefaultResources for the default
+// timeout. The objects are expected to be those of the release namespace.
+func Evaluate(wait *av1.ArmadaWait, objects []unstructured.Unstructured, started time.Time, now time.Time) (*Report, error) {
+	if wait == nil {
+		wait = &av1.ArmadaWait{}
+	}
+
+	timeout := time.Duration(plan.DefaultWaitTimeout) * time.Second
+	if wait.Timeout > 0 {
+		timeout = time.Duration(wait.Timeout) * time.Second
+	}
+	report := &Report{Timeout: timeout, Deadline: started.Add(timeout), Items: make([]ItemReport, 0)}
+
+	base := map[string]string{}
+	if wait.Labels != nil {
+		base = *wait.Labels
+	}
+
+	items := wait.Resources
+	required := true
+	if len(items) == 0 {
+		required = false
+		for _, t := range DefaultResources {
+			items = append(items, &av1.ArmadaWaitResourcesItems{Type: t})
+		}
+	}
+
+	ready := true
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		itemReport, err := evaluateItem(item, base, required, objects)
+		if err != nil {
+			return nil, err
+		}
+		ready = ready && itemReport.Ready
+		report.Items = append(report.Items, *itemReport)
+	}
+
+	switch {
+	case ready:
+		report.Verdict = VerdictReady
+	case !now.Before(report.Deadline):
+		report.Verdict = VerdictTimedOut
+	default:
+		report.Verdict = VerdictWaiting
+	}
+	return report, nil
+}
+
+func evaluateItem(item *av1.ArmadaWaitResourcesItems, base map[string]string, required bool,
+	objects []unstructured.Unstructured) (*ItemReport, error) {
+	kind, ok := kinds[item.Type]
+	if !ok {
+		return nil, fmt.Errorf("unsupported wait resource type %q", item.Type)
+	}
+
+	res := &ItemReport{Type: item.Type, Required: required, Resources: make([]ResourceReport, 0)}
+	merged := make(map[string]string)
+	for k, v := range base {
+		merged[k] = v
+	}
+	if item.Labels != nil {
+		for k, v := range *item.Labels {
+			merged[k] = v
+		}
+	}
+	if len(merged) > 0 {
+		res.Labels = merged
+	}
+	selector := labels.SelectorFromSet(merged)
+
+	check := rules[item.Type]
+	for i := range objects {
+		u := &objects[i]
+		if u.GroupVersionKind().GroupKind() != kind || !selector.Matches(labels.Set(u.GetLabels())) {
+			continue
+		}
+		if skip(item.Type, u) {
+			continue
+		}
+		ready, message, err := check(u, item.MinReady)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s/%s: %v", u.GetKind(), u.GetNamespace(), u.GetName(), err)
+		}
+		res.Resources = append(res.Resources, ResourceReport{
+			Kind:      u.GetKind(),
+			Namespace: u.GetNamespace(),
+			Name:      u.GetName(),
+			Ready:     ready,
+			Message:   message,
+		})
+	}
+	sort.Slice(res.Resources, func(i, j int) bool {
+		a, b := res.Resources[i], res.Resources[j]
+		if a.Namespace != b.Namespace {
+			return a.Namespace < b.Namespace
+		}
+		return a.Name < b.Name
+	})
+
+	if len(res.Resources) == 0 {
+		res.Ready = !required
+		if required {
+			res.Message = fmt.Sprintf("no %s found", item.Type)
+			if len(merged) > 0 {
+				res.Message = fmt.Sprintf("no %s matches the labels %v", item.Type, selector)
+			}
+		}
+		return res, nil
+	}
+
+	notReady := 0
+	for _, resource := range res.Resources {
+		if !resource.Ready {
+			notReady++
+		}
+	}
+	res.Ready = notReady == 0
+	if !res.Ready {
+		res.Message = fmt.Sprintf("%d of %d %s not ready", notReady, len(res.Resources), item.Type)
+	}
+	return res, nil
+}
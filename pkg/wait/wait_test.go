@@ -0,0 +1,201 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wait
+
+import (
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/yaml"
+)
+
+// The live objects of a keystone release
+const release = `
+- apiVersion: apps/v1
+  kind: Deployment
+  metadata: {name: keystone-api, namespace: openstack, generation: 2, labels: {release_group: osh-keystone, application: keystone}}
+  spec: {replicas: 5}
+  status: {observedGeneration: 2, replicas: 5, updatedReplicas: 5, readyReplicas: 4, availableReplicas: 4}
+- apiVersion: apps/v1
+  kind: StatefulSet
+  metadata: {name: mariadb, namespace: openstack, generation: 1, labels: {release_group: osh-mariadb}}
+  spec: {replicas: 3}
+  status: {observedGeneration: 1, replicas: 3, readyReplicas: 2, updatedReplicas: 3, currentRevision: a, updateRevision: a}
+- apiVersion: apps/v1
+  kind: DaemonSet
+  metadata: {name: ovs, namespace: openstack, generation: 1, labels: {release_group: osh-keystone, application: ovs}}
+  status: {observedGeneration: 1, desiredNumberScheduled: 4, updatedNumberScheduled: 4, numberAvailable: 3, numberUnavailable: 1}
+- apiVersion: batch/v1
+  kind: Job
+  metadata: {name: keystone-db-sync, namespace: openstack, labels: {release_group: osh-keystone, application: keystone}}
+  status: {succeeded: 1}
+- apiVersion: batch/v1
+  kind: Job
+  metadata: {name: keystone-bootstrap, namespace: openstack, labels: {release_group: osh-keystone, application: keystone}}
+  status:
+    failed: 3
+    conditions:
+    - {type: Failed, status: "True", reason: BackoffLimitExceeded}
+- apiVersion: v1
+  kind: Pod
+  metadata: {name: keystone-api-0, namespace: openstack, labels: {release_group: osh-keystone, application: keystone}}
+  status:
+    phase: Running
+    conditions:
+    - {type: Ready, status: "True"}
+- apiVersion: v1
+  kind: Pod
+  metadata: {name: keystone-api-1, namespace: openstack, labels: {release_group: osh-keystone, application: keystone}}
+  status:
+    phase: Pending
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: keystone-db-sync-x2d4
+    namespace: openstack
+    labels: {release_group: osh-keystone, application: keystone}
+    ownerReferences: [{apiVersion: batch/v1, kind: Job, name: keystone-db-sync, uid: "1"}]
+  status:
+    phase: Failed
+- apiVersion: v1
+  kind: Pod
+  metadata:
+    name: keystone-test
+    namespace: openstack
+    labels: {release_group: osh-keystone, application: keystone}
+    annotations: {helm.sh/hook: test-success}
+  status:
+    phase: Pending
+`
+
+func newObjects(t *testing.T) []unstructured.Unstructured {
+	items := make([]map[string]interface{}, 0)
+	if err := yaml.Unmarshal([]byte(release), &items); err != nil {
+		t.Fatalf("invalid objects: %v", err)
+	}
+	res := make([]unstructured.Unstructured, 0, len(items))
+	for _, item := range items {
+		res = append(res, unstructured.Unstructured{Object: item})
+	}
+	return res
+}
+
+func minReady(value string) *intstr.IntOrString {
+	res := intstr.Parse(value)
+	return &res
+}
+
+func TestEvaluateItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     av1.ArmadaWaitResourcesItems
+		ready    bool
+		names    []string
+		notReady []string
+	}{
+		{"deployment all", av1.ArmadaWaitResourcesItems{Type: "deployment"}, false, []string{"keystone-api"}, []string{"keystone-api"}},
+		{"deployment 80%", av1.ArmadaWaitResourcesItems{Type: "deployment", MinReady: minReady("80%")}, true, []string{"keystone-api"}, nil},
+		{"deployment 81%", av1.ArmadaWaitResourcesItems{Type: "deployment", MinReady: minReady("81%")}, false, []string{"keystone-api"}, []string{"keystone-api"}},
+		{"deployment count", av1.ArmadaWaitResourcesItems{Type: "deployment", MinReady: minReady("4")}, true, []string{"keystone-api"}, nil},
+		{"daemonset 75%", av1.ArmadaWaitResourcesItems{Type: "daemonset", MinReady: minReady("75%")}, true, []string{"ovs"}, nil},
+		{"daemonset all", av1.ArmadaWaitResourcesItems{Type: "daemonset"}, false, []string{"ovs"}, []string{"ovs"}},
+		{"job", av1.ArmadaWaitResourcesItems{Type: "job"}, false, []string{"keystone-bootstrap", "keystone-db-sync"}, []string{"keystone-bootstrap"}},
+		{"job by label", av1.ArmadaWaitResourcesItems{Type: "job", Labels: &map[string]string{"application": "ovs"}}, false, nil, nil},
+		{"pod", av1.ArmadaWaitResourcesItems{Type: "pod"}, false, []string{"keystone-api-0", "keystone-api-1"}, []string{"keystone-api-1"}},
+		{"statefulset of another release", av1.ArmadaWaitResourcesItems{Type: "statefulset"}, false, nil, nil},
+	}
+
+	objects := newObjects(t)
+	started := time.Now()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := gomega.NewGomegaWithT(t)
+			item := tt.item
+			wait := &av1.ArmadaWait{
+				Labels:    &map[string]string{"release_group": "osh-keystone"},
+				Resources: []*av1.ArmadaWaitResourcesItems{&item},
+			}
+			report, err := Evaluate(wait, objects, started, started)
+			g.Expect(err).NotTo(gomega.HaveOccurred())
+			g.Expect(report.Items).To(gomega.HaveLen(1))
+			g.Expect(report.Items[0].Ready).To(gomega.Equal(tt.ready))
+			g.Expect(report.Items[0].Labels).To(gomega.HaveKeyWithValue("release_group", "osh-keystone"))
+			names := make([]string, 0)
+			for _, resource := range report.Items[0].Resources {
+				names = append(names, resource.Name)
+			}
+			g.Expect(names).To(gomega.ConsistOf(tt.names))
+			notReady := make([]string, 0)
+			for _, resource := range report.NotReady() {
+				g.Expect(resource.Message).NotTo(gomega.BeEmpty())
+				notReady = append(notReady, resource.Name)
+			}
+			g.Expect(notReady).To(gomega.ConsistOf(tt.notReady))
+			if len(tt.names) == 0 {
+				g.Expect(report.Items[0].Message).To(gomega.HavePrefix("no " + tt.item.Type + " matches the labels"))
+			}
+		})
+	}
+}
+
+func TestEvaluateVerdict(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	objects := newObjects(t)
+	started := time.Date(2019, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// Pods and jobs by default, for the default timeout
+	report, err := Evaluate(nil, objects, started, started.Add(time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(report.Verdict).To(gomega.Equal(VerdictWaiting))
+	g.Expect(report.Timeout).To(gomega.Equal(900 * time.Second))
+	g.Expect(report.Items).To(gomega.HaveLen(2))
+	g.Expect(report.Items[0].Type).To(gomega.Equal("pod"))
+	g.Expect(report.Items[1].Type).To(gomega.Equal("job"))
+	g.Expect(report.Items[0].Required).To(gomega.BeFalse())
+
+	report, err = Evaluate(nil, objects, started, started.Add(15*time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(report.Verdict).To(gomega.Equal(VerdictTimedOut))
+	g.Expect(report.Ready()).To(gomega.BeFalse())
+
+	// The default types are optional
+	wait := &av1.ArmadaWait{Labels: &map[string]string{"release_group": "osh-mariadb"}, Timeout: 60}
+	report, err = Evaluate(wait, objects, started, started.Add(2*time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(report.Verdict).To(gomega.Equal(VerdictReady))
+	g.Expect(report.Deadline).To(gomega.Equal(started.Add(time.Minute)))
+
+	wait.Resources = []*av1.ArmadaWaitResourcesItems{{Type: "statefulset", MinReady: minReady("2")}}
+	report, err = Evaluate(wait, objects, started, started.Add(2*time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(report.Ready()).To(gomega.BeTrue())
+
+	wait.Resources = []*av1.ArmadaWaitResourcesItems{{Type: "statefulset"}}
+	report, err = Evaluate(wait, objects, started, started.Add(2*time.Minute))
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(report.Verdict).To(gomega.Equal(VerdictTimedOut))
+	g.Expect(report.NotReady()[0].Message).To(gomega.Equal("2 of 3 pods are ready, with min_ready 100%"))
+
+	wait.Resources = []*av1.ArmadaWaitResourcesItems{{Type: "replicaset"}}
+	_, err = Evaluate(wait, objects, started, started)
+	g.Expect(err).To(gomega.HaveOccurred())
+	wait.Resources = []*av1.ArmadaWaitResourcesItems{{Type: "statefulset", MinReady: minReady("most")}}
+	_, err = Evaluate(wait, objects, started, started)
+	g.Expect(err).To(gomega.HaveOccurred())
+}
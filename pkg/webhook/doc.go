@@ -0,0 +1,26 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook implements the defaulting and validating admission
+// webhooks of ArmadaChart, ArmadaChartGroup, ArmadaManifest, ArmadaBackup,
+// ArmadaBackupSchedule and ArmadaRestore. The Validate functions return
+// field.ErrorLists and can be used outside of the webhook server as well.
+//
+// The server also serves the /convert endpoint of the armada CRDs, which
+// have a v1alpha1 hub and a v1beta1 spoke. The generated CRDs do not serve
+// v1beta1: without a conversion webhook the API server would hand out the
+// stored v1alpha1 objects as v1beta1 ones. To serve it, set
+// spec.conversion.strategy to Webhook, with the service of the server, its
+// CA bundle and the /convert path, and mark v1beta1 as served.
+package webhook
@@ -0,0 +1,308 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"errors"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/keleustes/armada-crd/pkg/backup/schedule"
+	"github.com/keleustes/armada-crd/pkg/backup/storage"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Accepted values of the enum like string fields
+var (
+	// ArmadaChartSource.Type
+	validSourceTypes = sets.New("git", "local", "tar")
+	// ArmadaWaitResourcesItems.Type
+	validWaitResourceTypes = sets.New("deployment", "daemonset", "statefulset", "pod", "job")
+	// TargetState of every armada kind
+	validTargetStates = sets.New(
+		av1.StateUninitialized,
+		av1.StateInitialized,
+		av1.StateDeployed,
+		av1.StateUninstalled,
+	)
+)
+
+// ValidateArmadaChart checks the spec of an ArmadaChart
+func ValidateArmadaChart(obj *av1.ArmadaChart) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	spec := &obj.Spec
+
+	if spec.ChartName == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("chart_name"), ""))
+	}
+	if spec.Release == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("release"), ""))
+	}
+	allErrs = append(allErrs, validateSource(spec.Source, specPath.Child("source"))...)
+
+	for i, dep := range spec.Dependencies {
+		depPath := specPath.Child("dependencies").Index(i)
+		if dep == "" {
+			allErrs = append(allErrs, field.Required(depPath, ""))
+		} else if dep == obj.Name {
+			allErrs = append(allErrs, field.Invalid(depPath, dep, "a chart can not depend on itself"))
+		}
+	}
+
+	if spec.Wait != nil {
+		allErrs = append(allErrs, validateWait(spec.Wait, specPath.Child("wait"))...)
+	}
+	if spec.Delete != nil {
+		allErrs = append(allErrs, validateTimeout(spec.Delete.Timeout, specPath.Child("delete", "timeout"))...)
+	}
+	if spec.Test != nil {
+		allErrs = append(allErrs, validateTimeout(spec.Test.Timeout, specPath.Child("test", "timeout"))...)
+	}
+	if spec.Upgrade != nil {
+		allErrs = append(allErrs, validateUpgrade(spec.Upgrade, specPath.Child("upgrade"))...)
+	}
+	allErrs = append(allErrs, validateTimeout(int64(spec.Timeout), specPath.Child("timeout"))...)
+
+	allErrs = append(allErrs, validateTargetState(spec.TargetState, specPath.Child("target_state"))...)
+	if spec.Library && spec.TargetState == av1.StateDeployed {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("target_state"), spec.TargetState, "a library chart can not be deployed"))
+	}
+	allErrs = append(allErrs, validateRevisionHistoryLimit(spec.RevisionHistoryLimit, specPath.Child("revisionHistoryLimit"))...)
+	return allErrs
+}
+
+// ValidateArmadaChartGroup checks the spec of an ArmadaChartGroup
+func ValidateArmadaChartGroup(obj *av1.ArmadaChartGroup) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	allErrs = append(allErrs, validateReferences(obj.Spec.Charts, specPath.Child("chart_group"))...)
+	allErrs = append(allErrs, validateTargetState(obj.Spec.TargetState, specPath.Child("target_state"))...)
+	allErrs = append(allErrs, validateRevisionHistoryLimit(obj.Spec.RevisionHistoryLimit, specPath.Child("revisionHistoryLimit"))...)
+	return allErrs
+}
+
+// ValidateArmadaManifest checks the spec of an ArmadaManifest
+func ValidateArmadaManifest(obj *av1.ArmadaManifest) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+
+	if obj.Spec.ReleasePrefix == "" {
+		allErrs = append(allErrs, field.Required(specPath.Child("release_prefix"), ""))
+	}
+	allErrs = append(allErrs, validateReferences(obj.Spec.ChartGroups, specPath.Child("chart_groups"))...)
+	allErrs = append(allErrs, validateTargetState(obj.Spec.TargetState, specPath.Child("target_state"))...)
+	allErrs = append(allErrs, validateRevisionHistoryLimit(obj.Spec.RevisionHistoryLimit, specPath.Child("revisionHistoryLimit"))...)
+	return allErrs
+}
+
+// validateBackupSpec checks the spec of an ArmadaBackup, or the template of
+// an ArmadaBackupSchedule
+func validateBackupSpec(spec *av1.ArmadaBackupSpec, specPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	_, errs := storage.Resolve(spec.StorageType, spec.Offsite, spec.Ceph, spec.Local, specPath.Child("storageType"), specPath)
+	allErrs = append(allErrs, errs...)
+
+	if spec.BackupPolicy != nil {
+		allErrs = append(allErrs, validateTimeout(spec.BackupPolicy.TimeoutInSecond, specPath.Child("backupPolicy", "timeoutInSecond"))...)
+	}
+	allErrs = append(allErrs, validateReferences(spec.Charts, specPath.Child("charts"))...)
+	allErrs = append(allErrs, validateTargetState(spec.TargetState, specPath.Child("targetState"))...)
+	return allErrs
+}
+
+// ValidateArmadaBackup checks the spec of an ArmadaBackup
+func ValidateArmadaBackup(obj *av1.ArmadaBackup) field.ErrorList {
+	return validateBackupSpec(&obj.Spec, field.NewPath("spec"))
+}
+
+// ValidateArmadaBackupSchedule checks the schedule, the template and the
+// retention of an ArmadaBackupSchedule
+func ValidateArmadaBackupSchedule(obj *av1.ArmadaBackupSchedule) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	spec := &obj.Spec
+
+	if len(obj.Name) > schedule.MaxNameLength {
+		allErrs = append(allErrs, field.TooLong(field.NewPath("metadata", "name"), obj.Name, schedule.MaxNameLength))
+	}
+
+	schedulePath := specPath.Child("schedule")
+	if spec.Schedule == "" {
+		allErrs = append(allErrs, field.Required(schedulePath, ""))
+	} else if _, err := schedule.Parse(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(schedulePath, spec.Schedule, scheduleErrorReason(err)))
+	}
+	allErrs = append(allErrs, validateBackupSpec(&spec.Template, specPath.Child("template"))...)
+
+	if spec.Retention != nil {
+		retentionPath := specPath.Child("retention")
+		if spec.Retention.MaxCount < 0 {
+			allErrs = append(allErrs, field.Invalid(retentionPath.Child("maxCount"), spec.Retention.MaxCount, "must be greater than or equal to 0"))
+		}
+		if spec.Retention.MaxAge != nil && spec.Retention.MaxAge.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(retentionPath.Child("maxAge"), spec.Retention.MaxAge.Duration.String(), "must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateArmadaRestore checks the spec of an ArmadaRestore
+func ValidateArmadaRestore(obj *av1.ArmadaRestore) field.ErrorList {
+	allErrs := field.ErrorList{}
+	specPath := field.NewPath("spec")
+	spec := &obj.Spec
+
+	_, errs := storage.Resolve(spec.BackupStorageType,
+		(*av1.OffsiteBackupSource)(spec.Offsite),
+		(*av1.CephBackupSource)(spec.Ceph),
+		(*av1.LocalBackupSource)(spec.Local),
+		specPath.Child("backupStorageType"), specPath)
+	allErrs = append(allErrs, errs...)
+
+	allErrs = append(allErrs, validateReferences(spec.Charts, specPath.Child("charts"))...)
+	allErrs = append(allErrs, validateTargetState(spec.TargetState, specPath.Child("targetState"))...)
+	return allErrs
+}
+
+func validateSource(source *av1.ArmadaChartSource, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if source == nil {
+		return append(allErrs, field.Required(fldPath, ""))
+	}
+
+	if source.Type == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), ""))
+	} else if !validSourceTypes.Has(source.Type) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), source.Type, sets.List(validSourceTypes)))
+	}
+	if source.Location == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), ""))
+	}
+	return allErrs
+}
+
+func validateWait(wait *av1.ArmadaWait, fldPath *field.Path) field.ErrorList {
+	allErrs := validateTimeout(wait.Timeout, fldPath.Child("timeout"))
+
+	for i, item := range wait.Resources {
+		itemPath := fldPath.Child("resources").Index(i)
+		if item == nil {
+			allErrs = append(allErrs, field.Required(itemPath, ""))
+			continue
+		}
+		if item.Type == "" {
+			allErrs = append(allErrs, field.Required(itemPath.Child("type"), ""))
+		} else if !validWaitResourceTypes.Has(item.Type) {
+			allErrs = append(allErrs, field.NotSupported(itemPath.Child("type"), item.Type, sets.List(validWaitResourceTypes)))
+		}
+		allErrs = append(allErrs, validateMinReady(item.MinReady, itemPath.Child("min_ready"))...)
+	}
+	return allErrs
+}
+
+// validateMinReady accepts a count of pods or a percentage of the pods of
+// the controller.
+func validateMinReady(minReady *intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	if minReady == nil {
+		return nil
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(minReady, 100, true)
+	if err != nil || value < 0 {
+		return field.ErrorList{field.Invalid(fldPath, minReady.String(), "must be an integer greater than or equal to 0 or a percentage, e.g. 80%")}
+	}
+	if minReady.Type == intstr.String && value > 100 {
+		return field.ErrorList{field.Invalid(fldPath, minReady.String(), "must not be greater than 100%")}
+	}
+	return nil
+}
+
+func validateUpgrade(upgrade *av1.ArmadaUpgrade, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if upgrade.Pre != nil {
+		prePath := fldPath.Child("pre")
+		allErrs = append(allErrs, validateHookActions(upgrade.Pre.Create, prePath.Child("create"))...)
+		allErrs = append(allErrs, validateHookActions(upgrade.Pre.Delete, prePath.Child("delete"))...)
+		allErrs = append(allErrs, validateHookActions(upgrade.Pre.Update, prePath.Child("update"))...)
+	}
+	if upgrade.Post != nil {
+		allErrs = append(allErrs, validateHookActions(upgrade.Post.Create, fldPath.Child("post", "create"))...)
+	}
+	return allErrs
+}
+
+func validateHookActions(actions []*av1.ArmadaHookActionItems, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, action := range actions {
+		if action == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+		} else if action.Type == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i).Child("type"), ""))
+		}
+	}
+	return allErrs
+}
+
+// validateReferences checks a list of object names: no empty nor
+// duplicated entries.
+func validateReferences(names []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+	for i, name := range names {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+		} else if seen.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), name))
+		}
+		seen.Insert(name)
+	}
+	return allErrs
+}
+
+func validateTimeout(timeout int64, fldPath *field.Path) field.ErrorList {
+	if timeout < 0 {
+		return field.ErrorList{field.Invalid(fldPath, timeout, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
+// validateTargetState accepts an empty state, which is later defaulted.
+func validateTargetState(state av1.HelmResourceState, fldPath *field.Path) field.ErrorList {
+	if state != "" && !validTargetStates.Has(state) {
+		return field.ErrorList{field.NotSupported(fldPath, state, sets.List(validTargetStates))}
+	}
+	return nil
+}
+
+func validateRevisionHistoryLimit(limit *int32, fldPath *field.Path) field.ErrorList {
+	if limit != nil && *limit < 0 {
+		return field.ErrorList{field.Invalid(fldPath, *limit, "must be greater than or equal to 0")}
+	}
+	return nil
+}
+
+// scheduleErrorReason returns the cause of a schedule.ParseError without
+// the schedule, which field.Invalid already prints, and the whole message
+// of any other error
+func scheduleErrorReason(err error) string {
+	var parseErr *schedule.ParseError
+	if errors.As(err, &parseErr) && parseErr.Err != nil {
+		return parseErr.Err.Error()
+	}
+	return err.Error()
+}
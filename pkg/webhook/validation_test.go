@@ -0,0 +1,191 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	"github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func minReady(value string) *intstr.IntOrString {
+	res := intstr.Parse(value)
+	return &res
+}
+
+func newChart(name string) *av1.ArmadaChart {
+	return &av1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+		},
+		Spec: av1.ArmadaChartSpec{
+			ChartName: name,
+			Release:   name + "-release",
+			Source: &av1.ArmadaChartSource{
+				Type:     "local",
+				Location: "/opt/armada/helm-charts/" + name,
+				Subpath:  ".",
+			},
+			Dependencies: make([]string, 0),
+		},
+	}
+}
+
+func errorFields(errs field.ErrorList) []string {
+	res := make([]string, 0, len(errs))
+	for _, err := range errs {
+		res = append(res, err.Field)
+	}
+	return res
+}
+
+func TestValidateArmadaChart(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	g.Expect(ValidateArmadaChart(newChart("foo"))).To(gomega.BeEmpty())
+
+	chart := newChart("foo")
+	chart.Spec.Release = ""
+	chart.Spec.Source.Type = "svn"
+	chart.Spec.Dependencies = []string{"foo", ""}
+	chart.Spec.Timeout = -1
+	chart.Spec.Wait = &av1.ArmadaWait{
+		Timeout: -10,
+		Resources: []*av1.ArmadaWaitResourcesItems{
+			{Type: "job"},
+			{Type: "replicaset"},
+			{Type: "deployment", MinReady: minReady("80%")},
+			{Type: "deployment", MinReady: minReady("120%")},
+			{Type: "deployment", MinReady: minReady("most")},
+			{Type: "deployment", MinReady: minReady("-1")},
+		},
+	}
+	chart.Spec.Test = &av1.ArmadaTest{Timeout: -1}
+	chart.Spec.Delete = &av1.ArmadaDelete{Timeout: -1}
+	chart.Spec.TargetState = "running"
+	g.Expect(errorFields(ValidateArmadaChart(chart))).To(gomega.ConsistOf(
+		"spec.release",
+		"spec.source.type",
+		"spec.dependencies[0]",
+		"spec.dependencies[1]",
+		"spec.wait.timeout",
+		"spec.wait.resources[1].type",
+		"spec.wait.resources[3].min_ready",
+		"spec.wait.resources[4].min_ready",
+		"spec.wait.resources[5].min_ready",
+		"spec.delete.timeout",
+		"spec.test.timeout",
+		"spec.timeout",
+		"spec.target_state",
+	))
+
+	chart = newChart("foo")
+	chart.Spec.Source = nil
+	g.Expect(errorFields(ValidateArmadaChart(chart))).To(gomega.ConsistOf("spec.source"))
+
+	chart = newChart("helm-toolkit")
+	chart.Spec.Library = true
+	chart.Spec.TargetState = av1.StateDeployed
+	g.Expect(errorFields(ValidateArmadaChart(chart))).To(gomega.ConsistOf("spec.target_state"))
+}
+
+func TestValidateArmadaChartGroupAndManifest(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	group := &av1.ArmadaChartGroup{Spec: av1.ArmadaChartGroupSpec{Charts: []string{"a", "b", "a"}}}
+	g.Expect(errorFields(ValidateArmadaChartGroup(group))).To(gomega.ConsistOf("spec.chart_group[2]"))
+
+	manifest := &av1.ArmadaManifest{Spec: av1.ArmadaManifestSpec{ChartGroups: []string{"a", ""}}}
+	g.Expect(errorFields(ValidateArmadaManifest(manifest))).To(gomega.ConsistOf(
+		"spec.release_prefix",
+		"spec.chart_groups[1]",
+	))
+}
+
+func TestValidateArmadaBackupAndRestore(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	backup := &av1.ArmadaBackup{Spec: av1.ArmadaBackupSpec{StorageType: "S3"}}
+	g.Expect(errorFields(ValidateArmadaBackup(backup))).To(gomega.ConsistOf("spec.storageType"))
+
+	backup.Spec.StorageType = av1.BackupStorageTypeOffsite
+	g.Expect(errorFields(ValidateArmadaBackup(backup))).To(gomega.ConsistOf("spec.offsite"))
+
+	backup.Spec.Offsite = &av1.OffsiteBackupSource{Path: "mybucket"}
+	backup.Spec.BackupPolicy = &av1.BackupPolicy{TimeoutInSecond: -1}
+	g.Expect(errorFields(ValidateArmadaBackup(backup))).To(gomega.ConsistOf(
+		"spec.offsite.path",
+		"spec.backupPolicy.timeoutInSecond",
+	))
+
+	restore := &av1.ArmadaRestore{Spec: av1.ArmadaRestoreSpec{BackupStorageType: av1.BackupStorageTypeCeph}}
+	restore.Spec.Ceph = &av1.CephRestoreSource{Path: "mycephbucket/armada.backup"}
+	g.Expect(ValidateArmadaRestore(restore)).To(gomega.BeEmpty())
+
+	restore.Spec.Ceph.Path = "MyCephBucket/armada.backup"
+	g.Expect(errorFields(ValidateArmadaRestore(restore))).To(gomega.ConsistOf("spec.ceph.path"))
+
+	backup = &av1.ArmadaBackup{Spec: av1.ArmadaBackupSpec{StorageType: av1.BackupStorageTypeLocal}}
+	g.Expect(errorFields(ValidateArmadaBackup(backup))).To(gomega.ConsistOf("spec.local"))
+
+	backup.Spec.Local = &av1.LocalBackupSource{Path: "armada/armada.backup"}
+	g.Expect(errorFields(ValidateArmadaBackup(backup))).To(gomega.ConsistOf("spec.local.path"))
+
+	backup.Spec.Local.PersistentVolumeClaim = "armada-backups"
+	g.Expect(ValidateArmadaBackup(backup)).To(gomega.BeEmpty())
+
+	restore = &av1.ArmadaRestore{Spec: av1.ArmadaRestoreSpec{BackupStorageType: av1.BackupStorageTypeLocal}}
+	restore.Spec.Local = &av1.LocalRestoreSource{Path: "/var/lib/armada/backups/armada.backup"}
+	g.Expect(ValidateArmadaRestore(restore)).To(gomega.BeEmpty())
+}
+
+func TestValidateArmadaBackupSchedule(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	schedule := &av1.ArmadaBackupSchedule{Spec: av1.ArmadaBackupScheduleSpec{
+		Template: av1.ArmadaBackupSpec{StorageType: av1.BackupStorageTypeOffsite},
+	}}
+	g.Expect(errorFields(ValidateArmadaBackupSchedule(schedule))).To(gomega.ConsistOf(
+		"spec.schedule",
+		"spec.template.offsite",
+	))
+
+	schedule.Spec.Schedule = "0 2 * *"
+	schedule.Spec.Template.Offsite = &av1.OffsiteBackupSource{Path: "mybucket/armada.backup"}
+	schedule.Spec.Retention = &av1.BackupRetention{MaxCount: -1, MaxAge: &metav1.Duration{}}
+	g.Expect(errorFields(ValidateArmadaBackupSchedule(schedule))).To(gomega.ConsistOf(
+		"spec.schedule",
+		"spec.retention.maxCount",
+		"spec.retention.maxAge",
+	))
+
+	schedule.Spec.Schedule = "CRON_TZ=UTC 0 2 * * *"
+	schedule.Spec.Retention = &av1.BackupRetention{MaxCount: 7, MaxAge: &metav1.Duration{Duration: 720 * time.Hour}}
+	g.Expect(ValidateArmadaBackupSchedule(schedule)).To(gomega.BeEmpty())
+
+	// The name is a label value of the backups, with room for the suffix
+	// of their names
+	schedule.Name = strings.Repeat("a", 52)
+	g.Expect(ValidateArmadaBackupSchedule(schedule)).To(gomega.BeEmpty())
+	schedule.Name += "a"
+	g.Expect(errorFields(ValidateArmadaBackupSchedule(schedule))).To(gomega.ConsistOf("metadata.name"))
+}
@@ -0,0 +1,125 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// The paths below are the ones generated by the controller-runtime builder:
+// /mutate-<group with dashes>-<version>-<lowercase kind>
+
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadachart,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadacharts,verbs=create;update,versions=v1alpha1,name=marmadachart.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadachart,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadacharts,verbs=create;update,versions=v1alpha1,name=varmadachart.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadachartgroup,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadachartgroups,verbs=create;update,versions=v1alpha1,name=marmadachartgroup.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadachartgroup,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadachartgroups,verbs=create;update,versions=v1alpha1,name=varmadachartgroup.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadamanifest,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadamanifests,verbs=create;update,versions=v1alpha1,name=marmadamanifest.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadamanifest,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadamanifests,verbs=create;update,versions=v1alpha1,name=varmadamanifest.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadabackup,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadabackups,verbs=create;update,versions=v1alpha1,name=marmadabackup.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadabackup,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadabackups,verbs=create;update,versions=v1alpha1,name=varmadabackup.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadabackupschedule,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadabackupschedules,verbs=create;update,versions=v1alpha1,name=marmadabackupschedule.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadabackupschedule,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadabackupschedules,verbs=create;update,versions=v1alpha1,name=varmadabackupschedule.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/mutate-armada-airshipit-org-v1alpha1-armadarestore,mutating=true,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadarestores,verbs=create;update,versions=v1alpha1,name=marmadarestore.armada.airshipit.org,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-armada-airshipit-org-v1alpha1-armadarestore,mutating=false,failurePolicy=fail,sideEffects=None,groups=armada.airshipit.org,resources=armadarestores,verbs=create;update,versions=v1alpha1,name=varmadarestore.armada.airshipit.org,admissionReviewVersions=v1
+
+// SetupWithManager registers the defaulting and validating webhooks of
+// all the armada kinds with the webhook server of the manager.
+func SetupWithManager(mgr ctrl.Manager) error {
+	if err := setup(mgr, &av1.ArmadaChart{}, ValidateArmadaChart); err != nil {
+		return err
+	}
+	if err := setup(mgr, &av1.ArmadaChartGroup{}, ValidateArmadaChartGroup); err != nil {
+		return err
+	}
+	if err := setup(mgr, &av1.ArmadaManifest{}, ValidateArmadaManifest); err != nil {
+		return err
+	}
+	if err := setup(mgr, &av1.ArmadaBackup{}, ValidateArmadaBackup); err != nil {
+		return err
+	}
+	if err := setup(mgr, &av1.ArmadaBackupSchedule{}, ValidateArmadaBackupSchedule); err != nil {
+		return err
+	}
+	return setup(mgr, &av1.ArmadaRestore{}, ValidateArmadaRestore)
+}
+
+func setup[T client.Object](mgr ctrl.Manager, obj T, validateFunc func(T) field.ErrorList) error {
+	gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+	if err != nil {
+		return err
+	}
+	hook := &admissionHook[T]{
+		scheme:       mgr.GetScheme(),
+		groupKind:    gvk.GroupKind(),
+		validateFunc: validateFunc,
+	}
+	return ctrl.NewWebhookManagedBy(mgr, obj).
+		WithDefaulter(hook).
+		WithValidator(hook).
+		Complete()
+}
+
+// admissionHook adapts the scheme defaulting and the Validate function of
+// a kind to the admission.Defaulter and admission.Validator interfaces.
+type admissionHook[T client.Object] struct {
+	scheme       *runtime.Scheme
+	groupKind    schema.GroupKind
+	validateFunc func(T) field.ErrorList
+}
+
+var _ admission.Defaulter[*av1.ArmadaChart] = &admissionHook[*av1.ArmadaChart]{}
+var _ admission.Validator[*av1.ArmadaChart] = &admissionHook[*av1.ArmadaChart]{}
+
+// Default implements admission.Defaulter. It applies the SetDefaults_
+// functions registered with the scheme, as the clients do.
+func (h *admissionHook[T]) Default(ctx context.Context, obj T) error {
+	h.scheme.Default(obj)
+	return nil
+}
+
+// ValidateCreate implements admission.Validator
+func (h *admissionHook[T]) ValidateCreate(ctx context.Context, obj T) (admission.Warnings, error) {
+	return nil, h.validate(obj)
+}
+
+// ValidateUpdate implements admission.Validator
+func (h *admissionHook[T]) ValidateUpdate(ctx context.Context, oldObj, newObj T) (admission.Warnings, error) {
+	return nil, h.validate(newObj)
+}
+
+// ValidateDelete implements admission.Validator. Deletion is always allowed.
+func (h *admissionHook[T]) ValidateDelete(ctx context.Context, obj T) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate converts the field.ErrorList into the Invalid status error the
+// API server expects, keeping the field paths in the status details.
+func (h *admissionHook[T]) validate(obj T) error {
+	allErrs := h.validateFunc(obj)
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(h.groupKind, obj.GetName(), allErrs)
+}
@@ -0,0 +1,132 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/keleustes/armada-crd/pkg/apis"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+var c client.Client
+
+// TestMain starts an envtest control plane with the CRDs and the webhook
+// configurations of kubectl/, and serves the webhooks of this package.
+// The webhook configurations are rewritten by envtest to point at the
+// local server.
+func TestMain(m *testing.M) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		log.Fatal(err)
+	}
+	if err := apis.AddToScheme(scheme); err != nil {
+		log.Fatal(err)
+	}
+
+	// With the scheme, envtest points the conversion of the multi-version
+	// CRDs at the local server as well, so v1beta1 can be served here.
+	crdOptions := envtest.CRDInstallOptions{
+		Scheme: scheme,
+		Paths:  []string{filepath.Join("..", "..", "kubectl")},
+	}
+	if err := envtest.ReadCRDFiles(&crdOptions); err != nil {
+		log.Fatal(err)
+	}
+	crdOptions.Paths = nil
+	for _, crd := range crdOptions.CRDs {
+		for i := range crd.Spec.Versions {
+			crd.Spec.Versions[i].Served = true
+		}
+	}
+
+	t := &envtest.Environment{
+		CRDInstallOptions: crdOptions,
+		WebhookInstallOptions: envtest.WebhookInstallOptions{
+			Paths: []string{filepath.Join("..", "..", "kubectl", "webhook")},
+		},
+	}
+
+	cfg, err := t.Start()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	options := &t.WebhookInstallOptions
+	mgr, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:  scheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+		WebhookServer: webhook.NewServer(webhook.Options{
+			Host:    options.LocalServingHost,
+			Port:    options.LocalServingPort,
+			CertDir: options.LocalServingCertDir,
+		}),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := SetupWithManager(mgr); err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		if err := mgr.Start(ctx); err != nil {
+			log.Fatal(err)
+		}
+	}()
+	if err := waitForServer(options.LocalServingHost, options.LocalServingPort); err != nil {
+		log.Fatal(err)
+	}
+
+	if c, err = client.New(cfg, client.Options{Scheme: scheme}); err != nil {
+		log.Fatal(err)
+	}
+
+	code := m.Run()
+	cancel()
+	if err := t.Stop(); err != nil {
+		log.Printf("failed to stop the test environment: %v", err)
+	}
+	os.Exit(code)
+}
+
+// waitForServer blocks until the webhook server accepts TLS connections
+func waitForServer(host string, port int) error {
+	addr := net.JoinHostPort(host, fmt.Sprint(port))
+	dialer := &net.Dialer{Timeout: time.Second}
+	for i := 0; i < 20; i++ {
+		conn, err := tls.DialWithDialer(dialer, "tcp", addr, &tls.Config{InsecureSkipVerify: true}) // #nosec G402
+		if err == nil {
+			return conn.Close()
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+	return fmt.Errorf("webhook server %s not ready", addr)
+}
@@ -0,0 +1,109 @@
+// Copyright 2019 The Armada Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	av1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1alpha1"
+	bv1 "github.com/keleustes/armada-crd/pkg/apis/armada/v1beta1"
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWebhookArmadaChart(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// Defaulting
+	created := newChart("webhook-default")
+	g.Expect(c.Create(context.TODO(), created)).NotTo(gomega.HaveOccurred())
+	fetched := &av1.ArmadaChart{}
+	key := types.NamespacedName{Name: created.Name, Namespace: created.Namespace}
+	g.Expect(c.Get(context.TODO(), key, fetched)).NotTo(gomega.HaveOccurred())
+	g.Expect(fetched.Spec.TargetState).To(gomega.Equal(av1.StateDeployed))
+
+	// Validation on create
+	invalid := newChart("webhook-invalid")
+	invalid.Spec.Source.Type = "svn"
+	invalid.Spec.Wait = &av1.ArmadaWait{Timeout: -1}
+	err := c.Create(context.TODO(), invalid)
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+	status := err.(apierrors.APIStatus).Status()
+	fields := make([]string, 0)
+	for _, cause := range status.Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+	g.Expect(fields).To(gomega.ConsistOf("spec.source.type", "spec.wait.timeout"))
+
+	// Validation on update
+	updated := fetched.DeepCopy()
+	updated.Spec.Release = ""
+	err = c.Update(context.TODO(), updated)
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+	g.Expect(c.Delete(context.TODO(), fetched)).NotTo(gomega.HaveOccurred())
+}
+
+func TestWebhookArmadaBackup(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	backup := &av1.ArmadaBackup{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-backup", Namespace: "default"},
+		Spec: av1.ArmadaBackupSpec{
+			StorageType: av1.BackupStorageTypeCeph,
+		},
+	}
+	err := c.Create(context.TODO(), backup)
+	g.Expect(apierrors.IsInvalid(err)).To(gomega.BeTrue())
+
+	backup.Spec.Ceph = &av1.CephBackupSource{Path: "mybucket/armada.backup"}
+	g.Expect(c.Create(context.TODO(), backup)).NotTo(gomega.HaveOccurred())
+	g.Expect(backup.Spec.TargetState).To(gomega.Equal(av1.StateDeployed))
+	g.Expect(c.Delete(context.TODO(), backup)).NotTo(gomega.HaveOccurred())
+}
+
+func TestWebhookConversion(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	// Created as v1beta1, stored as v1alpha1
+	created := &bv1.ArmadaChart{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-conversion", Namespace: "default"},
+		Spec: bv1.ArmadaChartSpec{
+			ChartName: "webhook-conversion",
+			Release:   "webhook-conversion-release",
+			Source: &bv1.ArmadaChartSource{
+				Type:     "local",
+				Location: "/opt/armada/helm-charts/webhook-conversion",
+				Subpath:  ".",
+			},
+			Dependencies: []bv1.ChartReference{{Name: "helm-toolkit"}},
+			Wait:         &bv1.ArmadaWait{Timeout: &metav1.Duration{Duration: 2 * time.Minute}},
+			TargetState:  bv1.StateDeployed,
+		},
+	}
+	g.Expect(c.Create(context.TODO(), created)).NotTo(gomega.HaveOccurred())
+
+	fetched := &av1.ArmadaChart{}
+	key := types.NamespacedName{Name: created.Name, Namespace: created.Namespace}
+	g.Expect(c.Get(context.TODO(), key, fetched)).NotTo(gomega.HaveOccurred())
+	g.Expect(fetched.Spec.Dependencies).To(gomega.Equal([]string{"helm-toolkit"}))
+	g.Expect(fetched.Spec.Wait.Timeout).To(gomega.Equal(int64(120)))
+
+	g.Expect(c.Delete(context.TODO(), fetched)).NotTo(gomega.HaveOccurred())
+}